@@ -12,6 +12,7 @@ var enUS = &Language{
 		"auth.jwt-expired":               "Your authentication token is expired.",
 		"parse.invalid-query":            "Failed to parse query string due to invalid syntax or unexpected input format.",
 		"parse.json-invalid-body":        "The request Content-Type indicates JSON, but the request body is empty or invalid.",
+		"parse.xml-invalid-body":         "The request Content-Type indicates XML, but the request body is empty or invalid.",
 		"parse.invalid-content-for-type": "The request content does not match its type. E.g. invalid multipart/form-data or a problem with the file upload.",
 		"parse.error-in-request-body":    "Failed to read request body due to connection issues, timeouts, size mismatches, or corrupted data.",
 	},
@@ -19,6 +20,8 @@ var enUS = &Language{
 		rules: map[string]string{
 			"required":                           "The :field is required.",
 			"required.element":                   "The :field elements are required.",
+			"required_if":                        "The :field is required because of the value of the :other.",
+			"required_if.element":                "The :field elements are required because of the value of the :other.",
 			"float32":                            "The :field must be numeric.",
 			"float32.element":                    "The :field elements must be numeric.",
 			"float64":                            "The :field must be numeric.",
@@ -135,6 +138,8 @@ var enUS = &Language{
 			"alpha_num.element":                  "The :field elements may only contain letters and numbers.",
 			"starts_with":                        "The :field must start with one of the following values: :values.",
 			"starts_with.element":                "The :field elements must start with one of the following values: :values.",
+			"starts_with_bytes":                  "The :field must start with the expected signature.",
+			"starts_with_bytes.element":          "The :field elements must start with the expected signature.",
 			"ends_with":                          "The :field must end with one of the following values: :values.",
 			"ends_with.element":                  "The :field elements must end with one of the following values: :values.",
 			"doesnt_start_with":                  "The :field must not start with any of the following values: :values.",
@@ -157,19 +162,60 @@ var enUS = &Language{
 			"ipv6.element":                       "The :field elements must be valid IPv6 addresses.",
 			"json":                               "The :field must be a valid JSON string.",
 			"json.element":                       "The :field elements must be valid JSON strings.",
+			"canonical_json":                     "The :field must be a JSON string in canonical form.",
+			"canonical_json.element":             "The :field elements must be JSON strings in canonical form.",
+			"yaml":                               "The :field must be a valid YAML string.",
+			"yaml.element":                       "The :field elements must be valid YAML strings.",
+			"go_template":                        "The :field must be a valid Go template (:error).",
+			"go_template.element":                "The :field elements must be valid Go templates (:error).",
+			"utf8":                               "The :field must be a valid UTF-8 string.",
+			"utf8.element":                       "The :field elements must be valid UTF-8 strings.",
+			"normalized":                         "The :field must be in the :form Unicode normalization form.",
+			"normalized.element":                 "The :field elements must be in the :form Unicode normalization form.",
+			"no_confusables":                     "The :field must not mix characters from different scripts.",
+			"no_confusables.element":             "The :field elements must not mix characters from different scripts.",
+			"http_header":                        "The :field must not contain line breaks or control characters.",
+			"http_header.element":                "The :field elements must not contain line breaks or control characters.",
+			"no_html":                            "The :field must not contain HTML tags.",
+			"no_html.element":                    "The :field elements must not contain HTML tags.",
+			"cron":                               "The :field must be a valid cron expression.",
+			"cron.element":                       "The :field elements must be valid cron expressions.",
+			"css_color":                          "The :field must be a valid CSS color.",
+			"css_color.element":                  "The :field elements must be valid CSS colors.",
+			"lat_lng":                            "The :field must be a valid pair of coordinates.",
+			"lat_lng.element":                    "The :field elements must be valid pairs of coordinates.",
+			"money":                              "The :field must be a valid amount of money with its currency.",
+			"money.element":                      "The :field elements must be valid amounts of money with their currency.",
+			"no_secrets":                         "The :field must not contain secrets or credentials.",
+			"no_secrets.element":                 "The :field elements must not contain secrets or credentials.",
+			"from_func":                          ":error",
 			"url":                                "The :field must be a valid URL.",
 			"url.element":                        "The :field elements must be valid URLs.",
+			"safe_redirect":                      "The :field must be a relative URL or a URL to a trusted host.",
+			"safe_redirect.element":              "The :field elements must be relative URLs or URLs to trusted hosts.",
+			"dns_label":                          "The :field must be a valid DNS label.",
+			"dns_label.element":                  "The :field elements must be valid DNS labels.",
+			"resource_name":                      "The :field must be a valid resource name.",
+			"resource_name.element":              "The :field elements must be valid resource names.",
 			"uuid":                               "The :field must be a valid UUID.",
 			"uuid.element":                       "The :field elements must be valid UUIDs.",
 			"bool":                               "The :field must be a boolean.",
 			"bool.element":                       "The :field elements must be booleans.",
 			"same":                               "The :field and the :other must match.",
 			"same.element":                       "The :field elements and the :other must match.",
+			"same_array":                         "The :field and the :other must contain the same elements.",
+			"same_array.element":                 "The :field elements and the :other must contain the same elements.",
+			"equals":                             "The :field must be equal to :value.",
+			"equals.element":                     "The :field elements must be equal to :value.",
+			"matches_hash":                       "The :field is incorrect.",
+			"matches_hash.element":               "The :field elements are incorrect.",
 			"different":                          "The :field and the :other must be different.",
 			"different.element":                  "The :field elements and the :other must be different.",
 			"file":                               "The :field must be a file.",
 			"mime":                               "The :field must be a file of type: :values.",
 			"image":                              "The :field must be an image.",
+			"valid_image":                        "The :field must be a valid image.",
+			"image_max_pixels":                   "The :field must not have more than :max pixels.",
 			"extension":                          "The :field must be a file with one of the following extensions: :values.",
 			"file_count":                         "The :field must have exactly :value file(s).",
 			"min_file_count":                     "The :field must have at least :value file(s).",
@@ -197,6 +243,31 @@ var enUS = &Language{
 			"keys_in.element":                    "The :field elements keys must be one of the following: :values.",
 			"doesnt_end_with":                    "The :field must not end with any of the following values: :values.",
 			"doesnt_end_with.element":            "The :field elements must not end with any of the following values: :values.",
+			"mutually_exclusive":                 "Only one of the following fields can be provided: :others.",
+			"mutually_exclusive.element":         "Only one of the following fields can be provided: :others.",
+			"required_together":                  "The following fields must be provided together: :others.",
+			"required_together.element":          "The following fields must be provided together: :others.",
+			"required_count":                     "Exactly :count of the following fields must be provided: :others.",
+			"required_count.element":             "Exactly :count of the following fields must be provided: :others.",
+			"sorted":                             "The :field must be sorted.",
+			"sorted.element":                     "The :field elements must be sorted.",
+			"increasing":                         "The :field must be in increasing order.",
+			"increasing.element":                 "The :field elements must be in increasing order.",
+			"decreasing":                         "The :field must be in decreasing order.",
+			"decreasing.element":                 "The :field elements must be in decreasing order.",
+			"sum_equals":                         "The sum of the :field must be equal to :value.",
+			"sum_equals.element":                 "The sum of the :field elements must be equal to :value.",
+			"sum_equals_field":                   "The sum of the :field must be equal to the :other.",
+			"sum_equals_field.element":           "The sum of the :field elements must be equal to the :other.",
+			"sum_between":                        "The sum of the :field must be between :min and :max.",
+			"sum_between.element":                "The sum of the :field elements must be between :min and :max.",
+			"base64_image":                       "The :field must be a base64-encoded file of type :values, of at most :max bytes.",
+			"base64_image.element":               "The :field elements must be base64-encoded files of type :values, of at most :max bytes.",
+			"subset":                             "The :field must only contain values present in the :other.",
+			"subset.element":                     "The :field elements must only contain values present in the :other.",
+			"superset":                           "The :field must contain all the values of the :other.",
+			"superset.element":                   "The :field elements must contain all the values of the :other.",
+			"unknown_field":                      "The :field is not allowed.",
 		},
 		fields: map[string]string{
 			"":        "body",
@@ -202,6 +202,50 @@ func (suite *LangTestSuite) TestGet() {
 	suite.Equal("Line with an infinite amount of awesomeness", lang.Get("many-placeholders", ":placeholders", "awesomeness", ":count", "an infinite amount of"))
 }
 
+func (suite *LangTestSuite) TestHas() {
+	l := New()
+	if err := l.LoadAllAvailableLanguages(&osfs.FS{}); err != nil {
+		suite.Error(err)
+		return
+	}
+
+	lang := l.GetLanguage("en-US")
+	suite.True(lang.Has("malformed-request"))
+	suite.False(lang.Has("notaline"))
+	suite.True(lang.Has("validation.rules.required"))
+	suite.False(lang.Has("validation.rules.notarule"))
+	suite.False(lang.Has("validation.fields.notafield"))
+	suite.False(lang.Has("required"))
+}
+
+func (suite *LangTestSuite) TestHasLine() {
+	l := New()
+	if err := l.LoadAllAvailableLanguages(&osfs.FS{}); err != nil {
+		suite.Error(err)
+		return
+	}
+
+	lang := l.GetLanguage("en-US")
+	suite.True(lang.HasLine("malformed-request"))
+	suite.False(lang.HasLine("notaline"))
+	suite.False(lang.HasLine("validation.rules.required"))
+	suite.False(lang.HasLine("required"))
+}
+
+func (suite *LangTestSuite) TestKeys() {
+	l := New()
+	if err := l.LoadAllAvailableLanguages(&osfs.FS{}); err != nil {
+		suite.Error(err)
+		return
+	}
+
+	lang := l.GetLanguage("en-US")
+	suite.Equal([]string{"malformed-json", "malformed-request"}, lang.Keys("malformed-"))
+	suite.Equal([]string{}, lang.Keys("notaprefix"))
+	suite.Contains(lang.Keys(""), "custom-line")
+	suite.NotContains(lang.Keys(""), "required")
+}
+
 func (suite *LangTestSuite) TestMerge() {
 	dst := &Language{
 		lines: map[string]string{"line": "line 1"},
@@ -2,6 +2,7 @@ package lang
 
 import (
 	"maps"
+	"slices"
 	"strings"
 )
 
@@ -68,6 +69,41 @@ func (l *Language) Get(line string, placeholders ...string) string {
 	return convertEmptyLine(line, l.lines[line], placeholders)
 }
 
+// Has returns true if the language contains the given line.
+// Like `Get()`, use a dot-separated path for validation rules messages and field names.
+func (l *Language) Has(line string) bool {
+	var ok bool
+	if strings.HasPrefix(line, "validation.rules.") {
+		_, ok = l.validation.rules[line[17:]]
+	} else if strings.HasPrefix(line, "validation.fields.") {
+		_, ok = l.validation.fields[line[18:]]
+	} else {
+		_, ok = l.lines[line]
+	}
+	return ok
+}
+
+// HasLine returns true if the language contains the given normal line. Unlike `Has()`,
+// validation rules messages and field names are never matched, so the checked lines
+// are the same as the ones listed by `Keys()`.
+func (l *Language) HasLine(line string) bool {
+	_, ok := l.lines[line]
+	return ok
+}
+
+// Keys returns the sorted names of the normal lines (excluding validation rules
+// messages and field names) starting with the given prefix.
+func (l *Language) Keys(prefix string) []string {
+	keys := make([]string, 0)
+	for key := range l.lines {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
 func convertEmptyLine(entry, line string, placeholders []string) string {
 	if line == "" {
 		return entry
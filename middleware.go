@@ -2,11 +2,11 @@ package goyave
 
 import (
 	"net/http"
-	"strings"
 
 	"gorm.io/gorm"
 	"goyave.dev/goyave/v5/cors"
 	"goyave.dev/goyave/v5/util/errors"
+	"goyave.dev/goyave/v5/util/httputil"
 	"goyave.dev/goyave/v5/validation"
 )
 
@@ -158,6 +158,7 @@ func (m *validateRequestMiddleware) Handle(next Handler) Handler {
 				Config:                   m.Config(),
 				Logger:                   m.Logger(),
 				Extra:                    extra,
+				Request:                  r.Request(),
 			}
 			r.Extra[ExtraQueryValidationRules{}] = opt.Rules
 			var err []error
@@ -174,12 +175,13 @@ func (m *validateRequestMiddleware) Handle(next Handler) Handler {
 				Context:                  r.Context(),
 				Data:                     r.Data,
 				Rules:                    m.BodyRules(r).AsRules(),
-				ConvertSingleValueArrays: !strings.HasPrefix(contentType, "application/json"),
+				ConvertSingleValueArrays: !httputil.IsJSON(contentType),
 				Language:                 r.Lang,
 				DB:                       db,
 				Config:                   m.Config(),
 				Logger:                   m.Logger(),
 				Extra:                    extra,
+				Request:                  r.Request(),
 			}
 			r.Extra[ExtraBodyValidationRules{}] = opt.Rules
 			var err []error
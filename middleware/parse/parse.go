@@ -7,10 +7,10 @@ import (
 	"io"
 	"net/http"
 	"net/url"
-	"strings"
 
 	"goyave.dev/goyave/v5"
 	"goyave.dev/goyave/v5/util/fsutil"
+	"goyave.dev/goyave/v5/util/httputil"
 )
 
 // Middleware reading the raw request query and body.
@@ -22,8 +22,13 @@ import (
 // The body is read only if the "Content-Type" header is set. If
 // the body exceeds the configured max upload size (in MiB), "413 Request Entity Too Large"
 // is returned.
-// If the content type is "application/json", the middleware will attempt
+// If the content type is "application/json" (or any media type starting with "application/json"), the middleware will attempt
 // to unmarshal the body and put the result in the request's `Data`. If it fails, returns "400 Bad request".
+// If the content type is "application/xml" or "text/xml", the body is decoded into a `map[string]any`
+// where the keys are the names of the root element's children. Elements containing other elements are
+// converted to objects, the others to strings. Repeated elements are grouped in a slice and attributes
+// are ignored. If it fails, returns "400 Bad request".
+// Media type parameters (such as `charset`) are ignored when detecting the content type.
 // If the content-type has another value, Go's standard `ParseMultipartForm` is called. The result
 // is put inside the request's `Data` after being flattened.
 // If the form is not a multipart form, attempts `ParseForm`. If `ParseMultipartForm` or `ParseForm` return
@@ -80,14 +85,23 @@ func (m *Middleware) Handle(next goyave.Handler) goyave.Handler {
 				}
 
 				bodyBytes := bodyBuf.Bytes()
-				if strings.HasPrefix(contentType, "application/json") {
+				switch {
+				case httputil.IsJSON(contentType):
 					var body any
 					if err := json.Unmarshal(bodyBytes, &body); err != nil {
 						response.Status(http.StatusBadRequest)
 						r.Extra[goyave.ExtraParseError{}] = fmt.Errorf("%w: %w", goyave.ErrInvalidJSONBody, err)
 					}
 					r.Data = body
-				} else {
+				case httputil.IsXML(contentType):
+					body, err := decodeXML(bodyBytes)
+					if err != nil {
+						response.Status(http.StatusBadRequest)
+						r.Extra[goyave.ExtraParseError{}] = fmt.Errorf("%w: %w", goyave.ErrInvalidXMLBody, err)
+					} else {
+						r.Data = body
+					}
+				default:
 					req := r.Request()
 					req.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
 					r.Data, err = generateFlatMap(req, maxSize)
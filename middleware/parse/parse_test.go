@@ -152,6 +152,80 @@ func TestParseMiddleware(t *testing.T) {
 		}
 	})
 
+	t.Run("JSON family", func(t *testing.T) {
+		for _, contentType := range []string{"application/json; charset=utf-8", "Application/JSON", "application/json-patch+json"} {
+			t.Run(contentType, func(t *testing.T) {
+				request := testutil.NewTestRequest(http.MethodPost, "/parse", strings.NewReader(`{"a":"b"}`))
+				request.Header().Set("Content-Type", contentType)
+				request.Route = route
+
+				result := server.TestMiddleware(&Middleware{}, request, func(resp *goyave.Response, req *goyave.Request) {
+					assert.Equal(t, map[string]any{"a": "b"}, req.Data)
+					resp.Status(http.StatusOK)
+				})
+
+				assert.NoError(t, result.Body.Close())
+				assert.Equal(t, http.StatusOK, result.StatusCode)
+			})
+		}
+	})
+
+	t.Run("XML", func(t *testing.T) {
+		data := `<?xml version="1.0" encoding="UTF-8"?><user><name>John</name><roles><role>admin</role><role>user</role></roles></user>`
+		for _, contentType := range []string{"application/xml", "text/xml; charset=utf-8"} {
+			t.Run(contentType, func(t *testing.T) {
+				request := testutil.NewTestRequest(http.MethodPost, "/parse", strings.NewReader(data))
+				request.Header().Set("Content-Type", contentType)
+				request.Route = route
+
+				result := server.TestMiddleware(&Middleware{}, request, func(resp *goyave.Response, req *goyave.Request) {
+					expected := map[string]any{
+						"name": "John",
+						"roles": map[string]any{
+							"role": []any{"admin", "user"},
+						},
+					}
+					assert.Equal(t, expected, req.Data)
+					resp.Status(http.StatusOK)
+				})
+
+				assert.NoError(t, result.Body.Close())
+				assert.Equal(t, http.StatusOK, result.StatusCode)
+			})
+		}
+	})
+
+	t.Run("XML Invalid", func(t *testing.T) {
+		request := testutil.NewTestRequest(http.MethodPost, "/parse", strings.NewReader(`<user><name>John</user>`))
+		request.Lang = server.Lang.GetDefault()
+		request.Header().Set("Content-Type", "application/xml")
+		request.Route = route
+
+		result := server.TestMiddleware(&Middleware{}, request, func(_ *goyave.Response, _ *goyave.Request) {
+			assert.Fail(t, "Middleware should not pass")
+		})
+
+		assert.NoError(t, result.Body.Close())
+		assert.Equal(t, http.StatusBadRequest, result.StatusCode)
+		extraError, ok := request.Extra[goyave.ExtraParseError{}].(error)
+		require.True(t, ok)
+		assert.ErrorIs(t, extraError, goyave.ErrInvalidXMLBody)
+	})
+
+	t.Run("Unknown type falls back to form", func(t *testing.T) {
+		request := testutil.NewTestRequest(http.MethodPost, "/parse", strings.NewReader("a=b"))
+		request.Header().Set("Content-Type", "application/x-custom")
+		request.Route = route
+
+		result := server.TestMiddleware(&Middleware{}, request, func(resp *goyave.Response, req *goyave.Request) {
+			assert.Equal(t, map[string]any{}, req.Data)
+			resp.Status(http.StatusOK)
+		})
+
+		assert.NoError(t, result.Body.Close())
+		assert.Equal(t, http.StatusOK, result.StatusCode)
+	})
+
 	t.Run("Multipart", func(t *testing.T) {
 		body := &bytes.Buffer{}
 		writer := multipart.NewWriter(body)
@@ -0,0 +1,115 @@
+package parse
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+// maxXMLDepth the maximum nesting depth of XML elements, same as `encoding/json`.
+const maxXMLDepth = 10000
+
+// decodeXML decodes the given XML document into a generic structure.
+//
+// The children of the root element become the keys of the returned map. Elements
+// having child elements are converted to `map[string]any`, the others are converted
+// to their (trimmed) text content. If an element name is repeated within the same parent,
+// the values are grouped in a `[]any`. Attributes are ignored.
+// Returns an error if elements are nested deeper than `maxXMLDepth`.
+func decodeXML(data []byte) (map[string]any, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, errors.New("XML document has no root element")
+			}
+			return nil, err
+		}
+
+		if _, ok := token.(xml.StartElement); ok {
+			value, err := decodeXMLElement(decoder, 1)
+			if err != nil {
+				return nil, err
+			}
+			if err := checkXMLEnd(decoder); err != nil {
+				return nil, err
+			}
+			if object, ok := value.(map[string]any); ok {
+				return object, nil
+			}
+			return map[string]any{}, nil
+		}
+	}
+}
+
+func decodeXMLElement(decoder *xml.Decoder, depth int) (any, error) {
+	if depth > maxXMLDepth {
+		return nil, errors.New("XML document exceeded max depth")
+	}
+	var object map[string]any
+	text := strings.Builder{}
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			value, err := decodeXMLElement(decoder, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			if object == nil {
+				object = map[string]any{}
+			}
+			addXMLValue(object, t.Name.Local, value)
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			if object != nil {
+				return object, nil
+			}
+			return strings.TrimSpace(text.String()), nil
+		}
+	}
+}
+
+func addXMLValue(object map[string]any, name string, value any) {
+	existing, ok := object[name]
+	if !ok {
+		object[name] = value
+		return
+	}
+	if slice, ok := existing.([]any); ok {
+		object[name] = append(slice, value)
+		return
+	}
+	object[name] = []any{existing, value}
+}
+
+// checkXMLEnd makes sure there is nothing else than comments, processing
+// instructions or whitespaces after the root element.
+func checkXMLEnd(decoder *xml.Decoder) error {
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			return errors.New("XML document has more than one root element")
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) > 0 {
+				return errors.New("XML document has text after the root element")
+			}
+		}
+	}
+}
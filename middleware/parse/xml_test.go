@@ -0,0 +1,71 @@
+package parse
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeXML(t *testing.T) {
+	cases := []struct {
+		want    map[string]any
+		desc    string
+		data    string
+		wantErr bool
+	}{
+		{
+			desc: "simple",
+			data: `<root><a>b</a><c> d </c></root>`,
+			want: map[string]any{"a": "b", "c": "d"},
+		},
+		{
+			desc: "nested_and_repeated",
+			data: `<?xml version="1.0"?>
+<!-- comment -->
+<root attr="ignored">
+	<object><field>value</field></object>
+	<item>1</item>
+	<item>2</item>
+	<item>3</item>
+	<empty/>
+</root>`,
+			want: map[string]any{
+				"object": map[string]any{"field": "value"},
+				"item":   []any{"1", "2", "3"},
+				"empty":  "",
+			},
+		},
+		{desc: "empty_root", data: `<root/>`, want: map[string]any{}},
+		{desc: "text_root", data: `<root>text</root>`, want: map[string]any{}},
+		{desc: "empty", data: ``, wantErr: true},
+		{desc: "unclosed", data: `<root><a>b</a>`, wantErr: true},
+		{desc: "mismatched", data: `<root><a>b</c></root>`, wantErr: true},
+		{desc: "multiple_roots", data: `<root></root><root></root>`, wantErr: true},
+		{desc: "trailing_text", data: `<root></root>text`, wantErr: true},
+		{desc: "max_depth", data: strings.Repeat("<a>", maxXMLDepth) + strings.Repeat("</a>", maxXMLDepth), want: map[string]any{"a": nestedXMLObject(maxXMLDepth - 2)}},
+		{desc: "too_deep", data: strings.Repeat("<a>", maxXMLDepth+1) + strings.Repeat("</a>", maxXMLDepth+1), wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			res, err := decodeXML([]byte(c.data))
+			if c.wantErr {
+				require.Error(t, err)
+				assert.Nil(t, res)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.want, res)
+		})
+	}
+}
+
+func nestedXMLObject(depth int) any {
+	var value any = ""
+	for range depth {
+		value = map[string]any{"a": value}
+	}
+	return value
+}
@@ -320,6 +320,8 @@ func TestValidateMiddleware(t *testing.T) {
 				return validation.RuleSet{{Path: "param", Rules: validation.List{validation.Required(), &testValidator{
 					validateFunc: func(v *testValidator, ctx *validation.Context) bool {
 						assert.Equal(t, request, ctx.Extra[validation.ExtraRequest{}])
+						assert.Equal(t, request.Method(), ctx.Method)
+						assert.Equal(t, request.Request(), ctx.Request)
 						assert.NotNil(t, v.Config())
 						assert.NotNil(t, v.DB())
 						assert.NotNil(t, v.Logger())
@@ -396,6 +398,8 @@ func TestValidateMiddleware(t *testing.T) {
 				return validation.RuleSet{{Path: "param", Rules: validation.List{validation.Required(), &testValidator{
 					validateFunc: func(v *testValidator, ctx *validation.Context) bool {
 						assert.Equal(t, request, ctx.Extra[validation.ExtraRequest{}])
+						assert.Equal(t, request.Method(), ctx.Method)
+						assert.Equal(t, request.Request(), ctx.Request)
 						assert.NotNil(t, v.Config())
 						assert.NotNil(t, v.DB())
 						assert.NotNil(t, v.Logger())
@@ -42,6 +42,9 @@ var (
 	// ErrInvalidJSONBody error when an empty or malformed JSON body is sent.
 	ErrInvalidJSONBody = errors.New("parse middleware: could not JSON unmarshal body")
 
+	// ErrInvalidXMLBody error when an empty or malformed XML body is sent.
+	ErrInvalidXMLBody = errors.New("parse middleware: could not XML decode body")
+
 	// ErrInvalidContentForType error when e.g. a multipart form is not actually multipart, or empty.
 	ErrInvalidContentForType = errors.New("parse middleware: could not parse form")
 
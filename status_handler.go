@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 
+	"goyave.dev/goyave/v5/lang"
 	"goyave.dev/goyave/v5/validation"
 )
 
@@ -50,6 +51,8 @@ func (*ErrorStatusHandler) Handle(response *Response, _ *Request) {
 }
 
 // ParseErrorStatusHandler a generic (error) status handler for requests.
+// The error message is translated using the request's language. If the language
+// doesn't define the message, falls back to the default (English) message.
 type ParseErrorStatusHandler struct {
 	Component
 }
@@ -57,21 +60,23 @@ type ParseErrorStatusHandler struct {
 // Handle generic request (error) responses.
 func (h *ParseErrorStatusHandler) Handle(response *Response, request *Request) {
 	var errorMessage string
-	lang := request.Lang
+	language := request.Lang
 
 	err, ok := request.Extra[ExtraParseError{}].(error)
 	if ok {
 		switch {
 		case errors.Is(err, ErrInvalidJSONBody):
-			errorMessage = lang.Get("parse.json-invalid-body")
+			errorMessage = translateParseError(language, "parse.json-invalid-body")
+		case errors.Is(err, ErrInvalidXMLBody):
+			errorMessage = translateParseError(language, "parse.xml-invalid-body")
 		case errors.Is(err, ErrInvalidQuery):
-			errorMessage = lang.Get("parse.invalid-query")
+			errorMessage = translateParseError(language, "parse.invalid-query")
 		case errors.Is(err, ErrInvalidContentForType):
-			errorMessage = lang.Get("parse.invalid-content-for-type")
+			errorMessage = translateParseError(language, "parse.invalid-content-for-type")
 		case errors.Is(err, ErrErrorInRequestBody):
-			errorMessage = lang.Get("parse.error-in-request-body")
+			errorMessage = translateParseError(language, "parse.error-in-request-body")
 		default:
-			errorMessage = lang.Get(err.Error())
+			errorMessage = translateParseError(language, err.Error())
 		}
 	} else {
 		errorMessage = http.StatusText(response.GetStatus())
@@ -83,6 +88,19 @@ func (h *ParseErrorStatusHandler) Handle(response *Response, request *Request) {
 	response.JSON(response.GetStatus(), message)
 }
 
+// translateParseError translates the given entry using the given language. If the
+// language doesn't define it, the built-in `lang.Default` (English) is used instead of
+// the application's default language. This is intentional: custom languages may not
+// define the parse error entries, but the built-in English language always does.
+func translateParseError(language *lang.Language, entry string) string {
+	if language != nil {
+		if message := language.Get(entry); message != entry {
+			return message
+		}
+	}
+	return lang.Default.Get(entry)
+}
+
 // ValidationStatusHandler for HTTP 422 errors.
 // Writes the validation errors to the response.
 type ValidationStatusHandler struct {
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"testing/fstest"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -149,6 +150,12 @@ func TestParseErrorStatusHandler(t *testing.T) {
 			expectedMessage: "The request Content-Type indicates JSON, but the request body is empty or invalid.",
 			expectedStatus:  http.StatusBadRequest,
 		},
+		{
+			name:            "InvalidXMLBody",
+			err:             ErrInvalidXMLBody,
+			expectedMessage: "The request Content-Type indicates XML, but the request body is empty or invalid.",
+			expectedStatus:  http.StatusBadRequest,
+		},
 		{
 			name:            "InvalidQuery",
 			err:             ErrInvalidQuery,
@@ -200,6 +207,45 @@ func TestParseErrorStatusHandler(t *testing.T) {
 	}
 }
 
+func TestParseErrorStatusHandlerTranslation(t *testing.T) {
+	cases := []struct {
+		language        string
+		expectedMessage string
+	}{
+		{language: "en-US", expectedMessage: "The request Content-Type indicates JSON, but the request body is empty or invalid."},
+		{language: "fr-FR", expectedMessage: "Le corps de la requête est vide ou invalide."},
+		{language: "de-DE", expectedMessage: "The request Content-Type indicates JSON, but the request body is empty or invalid."},
+	}
+
+	for _, c := range cases {
+		t.Run(c.language, func(t *testing.T) {
+			req, resp, recorder := prepareStatusHandlerTest()
+			langFS := fstest.MapFS{
+				"fr-FR/locale.json": {Data: []byte(`{"parse.json-invalid-body": "Le corps de la requête est vide ou invalide."}`)},
+				"de-DE/locale.json": {Data: []byte(`{}`)},
+			}
+			require.NoError(t, resp.server.Lang.Load(langFS, "fr-FR", "fr-FR"))
+			require.NoError(t, resp.server.Lang.Load(langFS, "de-DE", "de-DE"))
+			req.Lang = resp.server.Lang.GetLanguage(c.language)
+
+			handler := &ParseErrorStatusHandler{}
+			handler.Init(resp.server)
+
+			req.Extra[ExtraParseError{}] = ErrInvalidJSONBody
+			resp.Status(http.StatusBadRequest)
+
+			handler.Handle(resp, req)
+
+			res := recorder.Result()
+			body, err := io.ReadAll(res.Body)
+			assert.NoError(t, res.Body.Close())
+			require.NoError(t, err)
+
+			assert.Equal(t, fmt.Sprintf(`{"error":"%s"}`, c.expectedMessage)+"\n", string(body))
+		})
+	}
+}
+
 func TestParseErrorStatusHandlerWithoutExtra(t *testing.T) {
 	req, resp, recorder := prepareStatusHandlerTest()
 
@@ -0,0 +1,167 @@
+package fsutil
+
+import (
+	"io/fs"
+	"slices"
+	"sync"
+	"time"
+
+	"goyave.dev/goyave/v5/util/errors"
+)
+
+type cachedStat struct {
+	expiresAt time.Time
+	info      fs.FileInfo
+}
+
+type cachedDir struct {
+	expiresAt time.Time
+	entries   []fs.DirEntry
+}
+
+// CachedFS is a `FS` wrapper memoizing the results of `Stat()` and `ReadDir()` for a fixed
+// duration. This is useful when the underlying file system is slow or remote, or when
+// the same files are stat-ed repeatedly (when serving static files for example).
+//
+// Only successful results are cached. Expired entries are refreshed the next time they
+// are accessed, but never removed: use `Clear()` to release the memory if the file system
+// contains a large number of files. Files opened using `Open()` are never cached.
+// It is safe for concurrent use.
+type CachedFS struct {
+	inner   FS
+	stats   map[string]cachedStat
+	dirs    map[string]cachedDir
+	now     func() time.Time
+	ttl     time.Duration
+	statsMu sync.Mutex
+	dirsMu  sync.Mutex
+}
+
+// NewCachedFS returns a new `CachedFS` memoizing the results of the `Stat()` and `ReadDir()`
+// methods of the given inner file system for the given duration.
+func NewCachedFS(inner FS, ttl time.Duration) *CachedFS {
+	return &CachedFS{
+		inner: inner,
+		ttl:   ttl,
+		stats: map[string]cachedStat{},
+		dirs:  map[string]cachedDir{},
+		now:   time.Now,
+	}
+}
+
+// Open opens the named file using the inner file system. The result is not cached.
+func (c *CachedFS) Open(name string) (fs.File, error) {
+	return c.inner.Open(name)
+}
+
+// Stat returns a FileInfo describing the named file. The result is taken
+// from the cache if it is not expired.
+func (c *CachedFS) Stat(name string) (fs.FileInfo, error) {
+	now := c.now()
+	c.statsMu.Lock()
+	entry, ok := c.stats[name]
+	c.statsMu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.info, nil
+	}
+
+	info, err := c.inner.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	c.statsMu.Lock()
+	c.stats[name] = cachedStat{info: info, expiresAt: now.Add(c.ttl)}
+	c.statsMu.Unlock()
+	return info, nil
+}
+
+// ReadDir reads the named directory and returns a list of directory entries sorted
+// by filename. The result is taken from the cache if it is not expired.
+func (c *CachedFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	now := c.now()
+	c.dirsMu.Lock()
+	entry, ok := c.dirs[name]
+	c.dirsMu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return slices.Clone(entry.entries), nil
+	}
+
+	entries, err := c.inner.ReadDir(name)
+	if err != nil {
+		return entries, err
+	}
+	c.dirsMu.Lock()
+	c.dirs[name] = cachedDir{entries: slices.Clone(entries), expiresAt: now.Add(c.ttl)}
+	c.dirsMu.Unlock()
+	return entries, nil
+}
+
+// Clear removes all the entries from the cache.
+func (c *CachedFS) Clear() {
+	c.statsMu.Lock()
+	clear(c.stats)
+	c.statsMu.Unlock()
+	c.dirsMu.Lock()
+	clear(c.dirs)
+	c.dirsMu.Unlock()
+}
+
+type cachedMIMEType struct {
+	modTime     time.Time
+	contentType string
+	size        int64
+}
+
+// CachingMIMEDetector memoizes the results of `GetMIMEType()` by file path. An entry is
+// invalidated when the modification time or the size of the file changes, so the content of
+// a file is only sniffed again if it was modified. This is useful when the same files are served
+// repeatedly. Files that have no modification time (such as embedded files) are considered
+// to never change.
+//
+// A detector should only be used with a single file system, as the entries are identified
+// by path only. Entries are never removed: use `Clear()` to release the memory if the
+// file system contains a large number of files. It is safe for concurrent use.
+type CachingMIMEDetector struct {
+	entries map[string]cachedMIMEType
+	mu      sync.RWMutex
+}
+
+// NewCachingMIMEDetector returns a new empty `CachingMIMEDetector`.
+func NewCachingMIMEDetector() *CachingMIMEDetector {
+	return &CachingMIMEDetector{
+		entries: map[string]cachedMIMEType{},
+	}
+}
+
+// GetMIMEType works like `fsutil.GetMIMEType()` but the result is taken from the cache
+// if the file was not modified since the last detection. The file is stat-ed on every call
+// but only opened if the result is not in the cache.
+func (d *CachingMIMEDetector) GetMIMEType(filesystem fs.FS, file string) (contentType string, size int64, err error) {
+	info, err := fs.Stat(filesystem, file)
+	if err != nil {
+		return "", 0, errors.New(err)
+	}
+
+	d.mu.RLock()
+	entry, ok := d.entries[file]
+	d.mu.RUnlock()
+	if ok && entry.modTime.Equal(info.ModTime()) && entry.size == info.Size() {
+		return entry.contentType, entry.size, nil
+	}
+
+	contentType, size, err = GetMIMEType(filesystem, file)
+	if err != nil {
+		return "", 0, err
+	}
+	d.mu.Lock()
+	d.entries[file] = cachedMIMEType{modTime: info.ModTime(), contentType: contentType, size: size}
+	d.mu.Unlock()
+	return contentType, size, nil
+}
+
+// Clear removes all the entries from the cache.
+func (d *CachingMIMEDetector) Clear() {
+	d.mu.Lock()
+	clear(d.entries)
+	d.mu.Unlock()
+}
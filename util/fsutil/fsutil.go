@@ -2,10 +2,13 @@ package fsutil
 
 import (
 	"bytes"
+	stderrors "errors"
+	"fmt"
 	"io"
 	"io/fs"
 	"mime"
 	"net/http"
+	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -126,11 +129,56 @@ func GetFileExtension(filename string) string {
 	return filename[index+1:]
 }
 
+// FileExtensionOptions options for `GetFileExtensionWithOptions()`.
+type FileExtensionOptions struct {
+	// IgnoreDotfiles if true, the leading dot of hidden files (dotfiles) is not considered as the
+	// start of an extension: ".gitignore" has no extension and ".config.json" has the "json" extension.
+	IgnoreDotfiles bool
+
+	// MultiPart if true, all the parts of the extension are returned: "archive.tar.gz"
+	// returns "tar.gz" instead of "gz". Beware that file names containing dots such as
+	// "my.photo.jpg" then return "photo.jpg".
+	MultiPart bool
+}
+
+// GetFileExtensionWithOptions returns the extension of the given file name, without the leading dot.
+// If the file doesn't have an extension, returns an empty string. With the zero value of
+// `FileExtensionOptions`, the behavior is the same as `GetFileExtension()`, except that
+// only the last element of the path is considered (so "dir.d/file" has no extension).
+func GetFileExtensionWithOptions(filename string, opts FileExtensionOptions) string {
+	filename = path.Base(filename)
+	if opts.IgnoreDotfiles {
+		filename = strings.TrimLeft(filename, ".")
+	}
+	var index int
+	if opts.MultiPart {
+		index = strings.Index(filename, ".")
+	} else {
+		index = strings.LastIndex(filename, ".")
+	}
+	if index == -1 {
+		return ""
+	}
+	return filename[index+1:]
+}
+
 // GetMIMEType get the mime type and size of the given file.
 // This function opens the file, stats it and calls `fsutil.DetectContentType`.
 // If the file is empty (size of 0), the content-type will be detected using `fsutil.DetectContentTypeByExtension`.
 // If a specific MIME type cannot be determined, returns "application/octet-stream" as a fallback.
 func GetMIMEType(filesystem fs.FS, file string) (contentType string, size int64, err error) {
+	return getMIMEType(filesystem, file, false)
+}
+
+// GetMIMETypeStrict works like `GetMIMEType()` but only relies on the content of the file:
+// the file extension is never used to find a more precise MIME type. This is useful in
+// security-sensitive flows where the file name provided by the user cannot be trusted.
+// Empty files are always detected as "application/octet-stream".
+func GetMIMETypeStrict(filesystem fs.FS, file string) (contentType string, size int64, err error) {
+	return getMIMEType(filesystem, file, true)
+}
+
+func getMIMEType(filesystem fs.FS, file string, strict bool) (contentType string, size int64, err error) {
 	var f fs.File
 	f, err = filesystem.Open(file)
 	if err != nil {
@@ -153,12 +201,20 @@ func GetMIMEType(filesystem fs.FS, file string) (contentType string, size int64,
 
 	size = stat.Size()
 
+	fileName := file
+	if strict {
+		fileName = ""
+	}
+
 	if size == 0 {
-		contentType = DetectContentTypeByExtension(file)
+		contentType = "application/octet-stream"
+		if !strict {
+			contentType = DetectContentTypeByExtension(file)
+		}
 		return
 	}
 
-	contentType, err = DetectContentType(f, file)
+	contentType, err = DetectContentType(f, fileName)
 	if err != nil {
 		err = errors.New(err)
 	}
@@ -166,6 +222,69 @@ func GetMIMEType(filesystem fs.FS, file string) (contentType string, size int64,
 	return
 }
 
+// ErrMIMETypeMismatch returned by `GetMIMETypeHinted()` when the given hint contradicts the detected MIME type.
+var ErrMIMETypeMismatch = stderrors.New("fsutil: MIME type hint doesn't match the file content")
+
+// GetMIMETypeHinted get the mime type and size of the given file, taking into account the given
+// MIME type hint (usually the "Content-Type" declared by the client). The MIME type is detected
+// using `GetMIMEType()`.
+//
+// The hint is returned if it is consistent with the detected MIME type:
+//   - both have the same media type (parameters such as "charset" are ignored)
+//   - the detected type is `"application/octet-stream"` (unknown binary content) and the hint is not a text type
+//   - both are text types (e.g. "text/plain" and "text/csv"), unless the hint is a type that can contain
+//     active content (HTML, XML or SVG), which must be detected as such
+//
+// Otherwise, the detected MIME type is returned along with `ErrMIMETypeMismatch` so the mismatch
+// can be detected using `errors.Is()` (to reject spoofed uploads for example).
+// If the hint is empty, the detected MIME type is returned.
+func GetMIMETypeHinted(filesystem fs.FS, file, hint string) (contentType string, size int64, err error) {
+	contentType, size, err = GetMIMEType(filesystem, file)
+	if err != nil || hint == "" {
+		return
+	}
+
+	hintMediaType, _, parseErr := mime.ParseMediaType(hint)
+	detectedMediaType, _, _ := mime.ParseMediaType(contentType)
+	if parseErr == nil && isMIMETypeConsistent(detectedMediaType, hintMediaType) {
+		return hint, size, nil
+	}
+	return contentType, size, errors.New(ErrMIMETypeMismatch)
+}
+
+func isMIMETypeConsistent(detected, hint string) bool {
+	switch {
+	case detected == hint:
+		return true
+	case detected == "application/octet-stream":
+		return !isTextMIMEType(hint)
+	case isTextMIMEType(detected) && isTextMIMEType(hint):
+		return !isActiveMIMEType(hint)
+	}
+	return false
+}
+
+// isTextMIMEType returns true if the given media type is a text format.
+func isTextMIMEType(mediaType string) bool {
+	switch mediaType {
+	case "application/json", "application/xml", "application/javascript", "application/x-sh",
+		"application/yaml", "application/x-yaml", "image/svg+xml":
+		return true
+	}
+	return strings.HasPrefix(mediaType, "text/") ||
+		strings.HasSuffix(mediaType, "+json") ||
+		strings.HasSuffix(mediaType, "+xml")
+}
+
+// isActiveMIMEType returns true if the given media type can contain active content such as scripts.
+func isActiveMIMEType(mediaType string) bool {
+	switch mediaType {
+	case "text/html", "text/xml", "application/xml", "application/xhtml+xml", "image/svg+xml":
+		return true
+	}
+	return false
+}
+
 // DetectContentType by sniffing the first 512 bytes of the given reader using `http.DetectContentType`.
 //
 // If the detected content type is `"application/octet-stream"` or `"text/plain"`, this function will attempt to
@@ -277,6 +396,54 @@ func IsDirectory(fs fs.StatFS, path string) bool {
 	return false
 }
 
+// Transform streams the content of "src" through the given function, which reads from
+// the source and writes the transformed content to "dst" (e.g. to resize or watermark an image).
+// The source is always closed, even if "fn" returns an error or panics.
+//
+// The error returned by "fn" is propagated. If "fn" succeeds, the error
+// returned when closing the source (if any) is returned instead.
+func Transform(src fs.File, dst io.Writer, fn func(io.Reader, io.Writer) error) (err error) {
+	defer func() {
+		closeError := src.Close()
+		if err == nil && closeError != nil {
+			err = errors.New(closeError)
+		}
+	}()
+
+	err = errors.New(fn(src, dst))
+	return
+}
+
+// ErrUnsafePath returned by `SafeJoin()` when the user-supplied path escapes the base directory.
+var ErrUnsafePath = stderrors.New("fsutil: path escapes the base directory")
+
+// SafeJoin joins the given user-supplied path to "base" and cleans the result.
+// Returns an error wrapping `ErrUnsafePath` if the resulting path is outside of "base"
+// after resolving the ".." elements (e.g. "../../etc/passwd"). Paths using ".." but staying
+// inside "base" are accepted. Absolute user paths are considered relative to "base",
+// the same way `http.Dir` does.
+//
+// Use this function to build the path of a file from user input (such as a route
+// parameter) before opening it, in order to prevent path traversal.
+func SafeJoin(base, userPath string) (string, error) {
+	if strings.ContainsRune(userPath, 0) {
+		return "", errors.New(fmt.Errorf("%w: %q", ErrUnsafePath, userPath))
+	}
+	base = path.Clean(base)
+	joined := path.Join(base, userPath)
+	switch {
+	case joined == base, base == "/":
+		return joined, nil
+	case base == ".":
+		if joined != ".." && !strings.HasPrefix(joined, "../") {
+			return joined, nil
+		}
+	case strings.HasPrefix(joined, base+"/"):
+		return joined, nil
+	}
+	return "", errors.New(fmt.Errorf("%w: %q", ErrUnsafePath, userPath))
+}
+
 func timestampFileName(name string) string {
 	var prefix string
 	var extension string
@@ -348,6 +515,14 @@ type RemoveFS interface {
 	RemoveAll(path string) error
 }
 
+// A RenameFS is a file system with a `Rename()` method.
+type RenameFS interface {
+	// Rename renames (moves) oldpath to newpath.
+	// If newpath already exists and is not a directory, Rename replaces it.
+	// If there is an error, it will be of type `*LinkError`.
+	Rename(oldpath, newpath string) error
+}
+
 // Embed is an extension of aimed at improving `embed.FS` by
 // implementing `fs.StatFS` and a `Sub()` function.
 type Embed struct {
@@ -1,23 +1,34 @@
 package fsutil
 
 import (
+	"archive/zip"
 	"bytes"
+	"crypto/sha256"
 	"embed"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/fs"
 	"math"
 	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
 	"net/textproto"
 	"os"
 	"path"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"testing/fstest"
+	"testing/iotest"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -90,6 +101,46 @@ func TestGetFileExtension(t *testing.T) {
 	assert.Equal(t, "png", GetFileExtension("test.png"))
 	assert.Equal(t, "gz", GetFileExtension("test.tar.gz"))
 	assert.Empty(t, GetFileExtension("test"))
+	assert.Equal(t, "gitignore", GetFileExtension(".gitignore"))
+	assert.Empty(t, GetFileExtension("trailing."))
+}
+
+func TestGetFileExtensionWithOptions(t *testing.T) {
+	cases := []struct {
+		filename string
+		want     string
+		opts     FileExtensionOptions
+	}{
+		{filename: "test.png", want: "png"},
+		{filename: "archive.tar.gz", want: "gz"},
+		{filename: ".gitignore", want: "gitignore"},
+		{filename: "noext", want: ""},
+		{filename: "trailing.", want: ""},
+		{filename: "dir.d/noext", want: ""},
+
+		{filename: ".gitignore", opts: FileExtensionOptions{IgnoreDotfiles: true}, want: ""},
+		{filename: "dir/.gitignore", opts: FileExtensionOptions{IgnoreDotfiles: true}, want: ""},
+		{filename: ".config.json", opts: FileExtensionOptions{IgnoreDotfiles: true}, want: "json"},
+		{filename: "archive.tar.gz", opts: FileExtensionOptions{IgnoreDotfiles: true}, want: "gz"},
+
+		{filename: "archive.tar.gz", opts: FileExtensionOptions{MultiPart: true}, want: "tar.gz"},
+		{filename: "test.png", opts: FileExtensionOptions{MultiPart: true}, want: "png"},
+		{filename: "noext", opts: FileExtensionOptions{MultiPart: true}, want: ""},
+		{filename: "trailing.", opts: FileExtensionOptions{MultiPart: true}, want: ""},
+		{filename: ".gitignore", opts: FileExtensionOptions{MultiPart: true}, want: "gitignore"},
+
+		{filename: ".gitignore", opts: FileExtensionOptions{IgnoreDotfiles: true, MultiPart: true}, want: ""},
+		{filename: ".backup.tar.gz", opts: FileExtensionOptions{IgnoreDotfiles: true, MultiPart: true}, want: "tar.gz"},
+		{filename: "dir.d/archive.tar.gz", opts: FileExtensionOptions{IgnoreDotfiles: true, MultiPart: true}, want: "tar.gz"},
+		{filename: "noext", opts: FileExtensionOptions{IgnoreDotfiles: true, MultiPart: true}, want: ""},
+		{filename: "trailing.", opts: FileExtensionOptions{IgnoreDotfiles: true, MultiPart: true}, want: ""},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%s_%t_%t", c.filename, c.opts.IgnoreDotfiles, c.opts.MultiPart), func(t *testing.T) {
+			assert.Equal(t, c.want, GetFileExtensionWithOptions(c.filename, c.opts))
+		})
+	}
 }
 
 func TestGetMIMEType(t *testing.T) {
@@ -188,6 +239,111 @@ func TestGetMIMEType(t *testing.T) {
 	})
 }
 
+func TestGetMIMETypeStrict(t *testing.T) {
+	content := []byte(`{"items":["` + strings.Repeat("a", 600) + `"]}`)
+	mapFS := fstest.MapFS{
+		"data.json":   {Data: content},
+		"short.json":  {Data: []byte(`{"a":1}`)},
+		"image.png":   {Data: []byte("\x89PNG\x0D\x0A\x1A\x0A")},
+		"script.js":   {Data: []byte("<html><body></body></html>")},
+		"empty.json":  {Data: []byte{}},
+		"image.svg":   {Data: []byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg"></svg>`)},
+		"unknown.ext": {Data: content},
+	}
+
+	cases := []struct {
+		file      string
+		want      string
+		wantLoose string
+		wantSize  int64
+	}{
+		{file: "data.json", want: "text/plain; charset=utf-8", wantLoose: "application/json; charset=utf-8", wantSize: int64(len(content))},
+		{file: "short.json", want: "application/octet-stream", wantLoose: "application/json", wantSize: 7},
+		{file: "image.png", want: "image/png", wantLoose: "image/png", wantSize: 8},
+		{file: "script.js", want: "text/html; charset=utf-8", wantLoose: "text/html; charset=utf-8", wantSize: 26},
+		{file: "empty.json", want: "application/octet-stream", wantLoose: "application/json", wantSize: 0},
+		{file: "image.svg", want: "image/svg+xml", wantLoose: "image/svg+xml", wantSize: 67},
+		{file: "unknown.ext", want: "text/plain; charset=utf-8", wantLoose: "text/plain; charset=utf-8", wantSize: int64(len(content))},
+	}
+
+	for _, c := range cases {
+		t.Run(c.file, func(t *testing.T) {
+			contentType, size, err := GetMIMETypeStrict(mapFS, c.file)
+			require.NoError(t, err)
+			assert.Equal(t, c.want, contentType)
+			assert.Equal(t, c.wantSize, size)
+
+			contentType, size, err = GetMIMEType(mapFS, c.file)
+			require.NoError(t, err)
+			assert.Equal(t, c.wantLoose, contentType)
+			assert.Equal(t, c.wantSize, size)
+		})
+	}
+
+	t.Run("not_found", func(t *testing.T) {
+		_, _, err := GetMIMETypeStrict(mapFS, "notafile")
+		require.ErrorIs(t, err, fs.ErrNotExist)
+	})
+}
+
+func TestGetMIMETypeHinted(t *testing.T) {
+	pngContent, err := os.ReadFile(toAbsolutePath("resources/img/logo/goyave_16.png"))
+	require.NoError(t, err)
+	mapFS := fstest.MapFS{
+		"logo.png":   {Data: pngContent},
+		"notes.txt":  {Data: []byte("some notes")},
+		"data.csv":   {Data: []byte("a,b\n1,2")},
+		"page.html":  {Data: []byte("<!DOCTYPE html><html><body>hello</body></html>")},
+		"binary.bin": {Data: []byte{0x00, 0x01, 0x02, 0x03, 0xfe, 0xff}},
+		"empty.json": {Data: []byte{}},
+	}
+
+	cases := []struct {
+		file     string
+		hint     string
+		wantMIME string
+		wantSize int64
+		mismatch bool
+	}{
+		{file: "logo.png", hint: "", wantMIME: "image/png", wantSize: 630},
+		{file: "logo.png", hint: "image/png", wantMIME: "image/png", wantSize: 630},
+		{file: "logo.png", hint: "IMAGE/PNG", wantMIME: "IMAGE/PNG", wantSize: 630},
+		{file: "logo.png", hint: "image/jpeg", wantMIME: "image/png", wantSize: 630, mismatch: true},
+		{file: "logo.png", hint: "text/html", wantMIME: "image/png", wantSize: 630, mismatch: true},
+		{file: "logo.png", hint: "not a mime type", wantMIME: "image/png", wantSize: 630, mismatch: true},
+		{file: "notes.txt", hint: "text/plain; charset=utf-8", wantMIME: "text/plain; charset=utf-8", wantSize: 10},
+		{file: "notes.txt", hint: "text/markdown", wantMIME: "text/markdown", wantSize: 10},
+		{file: "notes.txt", hint: "text/html", wantMIME: "text/plain", wantSize: 10, mismatch: true},
+		{file: "notes.txt", hint: "image/svg+xml", wantMIME: "text/plain", wantSize: 10, mismatch: true},
+		{file: "notes.txt", hint: "image/png", wantMIME: "text/plain", wantSize: 10, mismatch: true},
+		{file: "data.csv", hint: "text/plain", wantMIME: "text/plain", wantSize: 7},
+		{file: "page.html", hint: "text/html", wantMIME: "text/html", wantSize: 46},
+		{file: "page.html", hint: "text/plain", wantMIME: "text/plain", wantSize: 46},
+		{file: "binary.bin", hint: "application/x-custom", wantMIME: "application/x-custom", wantSize: 6},
+		{file: "binary.bin", hint: "text/plain", wantMIME: "application/octet-stream", wantSize: 6, mismatch: true},
+		{file: "empty.json", hint: "application/json", wantMIME: "application/json", wantSize: 0},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%s_%s", c.file, c.hint), func(t *testing.T) {
+			mime, size, err := GetMIMETypeHinted(mapFS, c.file, c.hint)
+			assert.Equal(t, c.wantMIME, mime)
+			assert.Equal(t, c.wantSize, size)
+			if c.mismatch {
+				require.ErrorIs(t, err, ErrMIMETypeMismatch)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("not_found", func(t *testing.T) {
+		mime, _, err := GetMIMETypeHinted(mapFS, "notafile", "image/png")
+		require.ErrorIs(t, err, fs.ErrNotExist)
+		assert.Empty(t, mime)
+	})
+}
+
 type testBuffer struct {
 	buf []byte
 	off int
@@ -377,6 +533,139 @@ func TestIsDirectory(t *testing.T) {
 	assert.False(t, IsDirectory(&osfs.FS{}, toAbsolutePath("doesn't exist")))
 }
 
+type closeTrackingFile struct {
+	fs.File
+	closeErr error
+	closed   bool
+}
+
+func (f *closeTrackingFile) Close() error {
+	f.closed = true
+	if err := f.File.Close(); err != nil {
+		return err
+	}
+	return f.closeErr
+}
+
+func TestTransform(t *testing.T) {
+	mapFS := fstest.MapFS{
+		"file.txt": {Data: []byte("hello world")},
+	}
+	open := func(t *testing.T) *closeTrackingFile {
+		f, err := mapFS.Open("file.txt")
+		require.NoError(t, err)
+		return &closeTrackingFile{File: f}
+	}
+
+	t.Run("identity", func(t *testing.T) {
+		src := open(t)
+		dst := &bytes.Buffer{}
+		err := Transform(src, dst, func(r io.Reader, w io.Writer) error {
+			_, err := io.Copy(w, r)
+			return err
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", dst.String())
+		assert.True(t, src.closed)
+	})
+
+	t.Run("transform", func(t *testing.T) {
+		src := open(t)
+		dst := &bytes.Buffer{}
+		err := Transform(src, dst, func(r io.Reader, w io.Writer) error {
+			content, err := io.ReadAll(r)
+			if err != nil {
+				return err
+			}
+			_, err = w.Write(bytes.ToUpper(content))
+			return err
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "HELLO WORLD", dst.String())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		src := open(t)
+		transformErr := fmt.Errorf("test error")
+		err := Transform(src, &bytes.Buffer{}, func(_ io.Reader, _ io.Writer) error {
+			return transformErr
+		})
+		require.ErrorIs(t, err, transformErr)
+		assert.IsType(t, &errors.Error{}, err)
+		assert.True(t, src.closed)
+	})
+
+	t.Run("close_error", func(t *testing.T) {
+		src := open(t)
+		closeErr := fmt.Errorf("close error")
+		src.closeErr = closeErr
+		err := Transform(src, &bytes.Buffer{}, func(_ io.Reader, _ io.Writer) error {
+			return nil
+		})
+		require.ErrorIs(t, err, closeErr)
+	})
+
+	t.Run("transform_error_has_priority", func(t *testing.T) {
+		src := open(t)
+		src.closeErr = fmt.Errorf("close error")
+		transformErr := fmt.Errorf("test error")
+		err := Transform(src, &bytes.Buffer{}, func(_ io.Reader, _ io.Writer) error {
+			return transformErr
+		})
+		require.ErrorIs(t, err, transformErr)
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		src := open(t)
+		assert.Panics(t, func() {
+			_ = Transform(src, &bytes.Buffer{}, func(_ io.Reader, _ io.Writer) error {
+				panic("test panic")
+			})
+		})
+		assert.True(t, src.closed)
+	})
+}
+
+func TestSafeJoin(t *testing.T) {
+	cases := []struct {
+		base     string
+		userPath string
+		want     string
+		wantErr  bool
+	}{
+		{base: "resources", userPath: "img/logo.png", want: "resources/img/logo.png"},
+		{base: "resources/", userPath: "./img//logo.png", want: "resources/img/logo.png"},
+		{base: "resources", userPath: "", want: "resources"},
+		{base: "resources", userPath: "img/../logo.png", want: "resources/logo.png"},
+		{base: "resources", userPath: "img/../../resources/logo.png", want: "resources/logo.png"},
+		{base: "resources", userPath: "/img/logo.png", want: "resources/img/logo.png"},
+		{base: "resources", userPath: "/../logo.png", wantErr: true},
+		{base: "resources", userPath: "../logo.png", wantErr: true},
+		{base: "resources", userPath: "..", wantErr: true},
+		{base: "resources", userPath: "img/../../../etc/passwd", wantErr: true},
+		{base: "resources", userPath: "../resources-private/secret", wantErr: true},
+		{base: "resources", userPath: "logo\x00.png", wantErr: true},
+		{base: ".", userPath: "img/logo.png", want: "img/logo.png"},
+		{base: ".", userPath: "../logo.png", wantErr: true},
+		{base: "", userPath: "/logo.png", want: "logo.png"},
+		{base: "/var/www", userPath: "../../etc/passwd", wantErr: true},
+		{base: "/", userPath: "../etc/passwd", want: "/etc/passwd"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%s_%s", c.base, c.userPath), func(t *testing.T) {
+			res, err := SafeJoin(c.base, c.userPath)
+			if c.wantErr {
+				require.ErrorIs(t, err, ErrUnsafePath)
+				assert.Empty(t, res)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.want, res)
+		})
+	}
+}
+
 func TestSave(t *testing.T) {
 	fs := &osfs.FS{}
 	file := createTestFiles("resources/img/logo/goyave_16.png")[0]
@@ -417,6 +706,571 @@ func TestSave(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestStoreByHash(t *testing.T) {
+	dir := t.TempDir()
+	fs := osfs.New(dir)
+
+	p, existed, err := StoreByHash(fs, "uploads", strings.NewReader("hello world"), sha256.New())
+	require.NoError(t, err)
+	assert.False(t, existed)
+	digest := "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+	assert.Equal(t, "uploads/b9/4d/"+digest, p)
+	content, err := os.ReadFile(filepath.Join(dir, p))
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(content))
+
+	p2, existed, err := StoreByHash(fs, "uploads", strings.NewReader("hello world"), sha256.New())
+	require.NoError(t, err)
+	assert.True(t, existed)
+	assert.Equal(t, p, p2)
+
+	p3, existed, err := StoreByHash(fs, "uploads", strings.NewReader("other content"), sha256.New())
+	require.NoError(t, err)
+	assert.False(t, existed)
+	assert.NotEqual(t, p, p3)
+	content, err = os.ReadFile(filepath.Join(dir, p3))
+	require.NoError(t, err)
+	assert.Equal(t, "other content", string(content))
+
+	// No temporary file left
+	entries, err := os.ReadDir(filepath.Join(dir, "uploads"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"92", "b9"}, lo.Map(entries, func(e os.DirEntry, _ int) string { return e.Name() }))
+
+	t.Run("read_error", func(t *testing.T) {
+		readErr := fmt.Errorf("read error")
+		_, _, err := StoreByHash(fs, "errors", iotest.ErrReader(readErr), sha256.New())
+		require.ErrorIs(t, err, readErr)
+		entries, err := os.ReadDir(filepath.Join(dir, "errors"))
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("mkdir_error", func(t *testing.T) {
+		_, _, err := StoreByHash(fs, "uploads/b9/4d/"+digest, strings.NewReader("content"), sha256.New())
+		require.Error(t, err)
+	})
+}
+
+func TestSaveAndHash(t *testing.T) {
+	dir := t.TempDir()
+	fs := osfs.New(dir)
+
+	content := bytes.Repeat([]byte("hello world "), 1000)
+	h := sha256.New()
+	h.Write([]byte("previous content"))
+	name, digest, err := SaveAndHash(fs, "uploads/tmp", bytes.NewReader(content), h)
+	require.NoError(t, err)
+
+	expected := sha256.Sum256(content)
+	assert.Equal(t, hex.EncodeToString(expected[:]), digest)
+	_, err = uuid.Parse(name)
+	require.NoError(t, err)
+	saved, err := os.ReadFile(filepath.Join(dir, "uploads", "tmp", name))
+	require.NoError(t, err)
+	assert.Equal(t, content, saved)
+
+	t.Run("read_error", func(t *testing.T) {
+		readErr := fmt.Errorf("read error")
+		name, digest, err := SaveAndHash(fs, "errors", iotest.ErrReader(readErr), sha256.New())
+		require.ErrorIs(t, err, readErr)
+		assert.Empty(t, name)
+		assert.Empty(t, digest)
+	})
+
+	t.Run("mkdir_error", func(t *testing.T) {
+		_, _, err := SaveAndHash(fs, path.Join("uploads", "tmp", name), strings.NewReader("content"), sha256.New())
+		require.Error(t, err)
+	})
+}
+
+type nonSeekerFS struct {
+	fstest.MapFS
+}
+
+type nonSeekerFile struct {
+	fs.File
+}
+
+func (f nonSeekerFS) Open(name string) (fs.File, error) {
+	file, err := f.MapFS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return nonSeekerFile{File: file}, nil
+}
+
+func TestTempFile(t *testing.T) {
+	dir := t.TempDir()
+	fs := osfs.New(dir)
+
+	file, filePath, cleanup, err := TempFile(fs, "tmp/uploads", "upload-*.png")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(filePath, "tmp/uploads/upload-"))
+	assert.True(t, strings.HasSuffix(filePath, ".png"))
+
+	_, err = file.Write([]byte("content"))
+	require.NoError(t, err)
+	_, err = file.(io.Seeker).Seek(0, io.SeekStart)
+	require.NoError(t, err)
+	content, err := io.ReadAll(file)
+	require.NoError(t, err)
+	assert.Equal(t, "content", string(content))
+
+	_, err = os.Stat(filepath.Join(dir, filePath))
+	require.NoError(t, err)
+
+	require.NoError(t, cleanup())
+	_, err = os.Stat(filepath.Join(dir, filePath))
+	require.ErrorIs(t, err, os.ErrNotExist)
+	require.NoError(t, cleanup()) // Calling cleanup twice is harmless
+
+	t.Run("closed_by_caller", func(t *testing.T) {
+		file, filePath, cleanup, err := TempFile(fs, "tmp", "upload")
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(filePath, "tmp/upload"))
+		require.NoError(t, file.Close())
+		require.NoError(t, cleanup())
+		_, err = os.Stat(filepath.Join(dir, filePath))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("unique", func(t *testing.T) {
+		_, filePath1, cleanup1, err := TempFile(fs, "tmp", "*")
+		require.NoError(t, err)
+		defer func() { require.NoError(t, cleanup1()) }()
+		_, filePath2, cleanup2, err := TempFile(fs, "tmp", "*")
+		require.NoError(t, err)
+		defer func() { require.NoError(t, cleanup2()) }()
+		assert.NotEqual(t, filePath1, filePath2)
+	})
+
+	t.Run("invalid_pattern", func(t *testing.T) {
+		file, filePath, cleanup, err := TempFile(fs, "tmp", "../upload-*")
+		require.Error(t, err)
+		assert.Nil(t, file)
+		assert.Empty(t, filePath)
+		assert.Nil(t, cleanup)
+	})
+
+	t.Run("mkdir_error", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "not_a_dir"), []byte("content"), 0600))
+		_, _, _, err := TempFile(fs, "not_a_dir", "upload-*")
+		require.Error(t, err)
+	})
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	fs := osfs.New(dir)
+
+	require.NoError(t, WriteFileAtomic(fs, "config/state.json", []byte(`{"a":1}`), 0600))
+	content, err := os.ReadFile(filepath.Join(dir, "config", "state.json"))
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, string(content))
+
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(filepath.Join(dir, "config", "state.json"))
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+
+	// Replace existing file
+	require.NoError(t, WriteFileAtomic(fs, "config/state.json", []byte(`{"a":2}`), 0600))
+	content, err = os.ReadFile(filepath.Join(dir, "config", "state.json"))
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":2}`, string(content))
+
+	// No temporary file left
+	entries, err := os.ReadDir(filepath.Join(dir, "config"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"state.json"}, lo.Map(entries, func(e os.DirEntry, _ int) string { return e.Name() }))
+
+	t.Run("rename_error", func(t *testing.T) {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "config", "dir", "child"), 0700))
+		err := WriteFileAtomic(fs, "config/dir", []byte("content"), 0600)
+		require.Error(t, err)
+
+		entries, err := os.ReadDir(filepath.Join(dir, "config"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dir", "state.json"}, lo.Map(entries, func(e os.DirEntry, _ int) string { return e.Name() }))
+	})
+
+	t.Run("mkdir_error", func(t *testing.T) {
+		err := WriteFileAtomic(fs, "config/state.json/file", []byte("content"), 0600)
+		require.Error(t, err)
+	})
+}
+
+func TestServeFile(t *testing.T) {
+	modTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mapFS := fstest.MapFS{
+		"video.txt": {Data: []byte("0123456789"), ModTime: modTime},
+		"dir/a.txt": {Data: []byte("a")},
+	}
+
+	serve := func(t *testing.T, filesystem fs.FS, file string, headers map[string]string) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(http.MethodGet, "/"+file, nil)
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		recorder := httptest.NewRecorder()
+		err := ServeFile(recorder, req, filesystem, file)
+		return recorder, err
+	}
+
+	t.Run("full", func(t *testing.T) {
+		recorder, err := serve(t, mapFS, "video.txt", nil)
+		require.NoError(t, err)
+		res := recorder.Result()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "bytes", res.Header.Get("Accept-Ranges"))
+		assert.Equal(t, "10", res.Header.Get("Content-Length"))
+		assert.Equal(t, "text/plain", res.Header.Get("Content-Type"))
+		assert.Equal(t, modTime.Format(http.TimeFormat), res.Header.Get("Last-Modified"))
+		assert.Empty(t, res.Header.Get("Content-Range"))
+		assert.Equal(t, "0123456789", recorder.Body.String())
+	})
+
+	t.Run("range", func(t *testing.T) {
+		recorder, err := serve(t, mapFS, "video.txt", map[string]string{"Range": "bytes=2-5"})
+		require.NoError(t, err)
+		res := recorder.Result()
+		assert.Equal(t, http.StatusPartialContent, res.StatusCode)
+		assert.Equal(t, "bytes", res.Header.Get("Accept-Ranges"))
+		assert.Equal(t, "bytes 2-5/10", res.Header.Get("Content-Range"))
+		assert.Equal(t, "4", res.Header.Get("Content-Length"))
+		assert.Equal(t, "2345", recorder.Body.String())
+	})
+
+	t.Run("suffix_range", func(t *testing.T) {
+		recorder, err := serve(t, mapFS, "video.txt", map[string]string{"Range": "bytes=-3"})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusPartialContent, recorder.Code)
+		assert.Equal(t, "bytes 7-9/10", recorder.Header().Get("Content-Range"))
+		assert.Equal(t, "789", recorder.Body.String())
+	})
+
+	t.Run("unsatisfiable_range", func(t *testing.T) {
+		recorder, err := serve(t, mapFS, "video.txt", map[string]string{"Range": "bytes=20-30"})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, recorder.Code)
+		assert.Equal(t, "bytes */10", recorder.Header().Get("Content-Range"))
+	})
+
+	t.Run("content_type_already_set", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/video.txt", nil)
+		recorder := httptest.NewRecorder()
+		recorder.Header().Set("Content-Type", "video/mp4")
+		require.NoError(t, ServeFile(recorder, req, mapFS, "video.txt"))
+		assert.Equal(t, "video/mp4", recorder.Header().Get("Content-Type"))
+	})
+
+	t.Run("non_seeker", func(t *testing.T) {
+		recorder, err := serve(t, nonSeekerFS{MapFS: mapFS}, "video.txt", map[string]string{"Range": "bytes=2-5"})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusPartialContent, recorder.Code)
+		assert.Equal(t, "2345", recorder.Body.String())
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		recorder, err := serve(t, mapFS, "notafile", nil)
+		require.ErrorIs(t, err, fs.ErrNotExist)
+		assert.Empty(t, recorder.Body.String())
+	})
+
+	t.Run("directory", func(t *testing.T) {
+		recorder, err := serve(t, mapFS, "dir", nil)
+		require.Error(t, err)
+		assert.Empty(t, recorder.Body.String())
+	})
+}
+
+func TestServeFileConditional(t *testing.T) {
+	modTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mapFS := fstest.MapFS{
+		"app.js": {Data: []byte("console.log('v1')"), ModTime: modTime},
+	}
+
+	serve := func(t *testing.T, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/app.js", nil)
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		recorder := httptest.NewRecorder()
+		require.NoError(t, ServeFile(recorder, req, mapFS, "app.js"))
+		return recorder
+	}
+
+	first := serve(t, nil)
+	assert.Equal(t, http.StatusOK, first.Code)
+	etag := first.Header().Get("ETag")
+	assert.Equal(t, fmt.Sprintf(`W/"11-%x"`, modTime.UnixNano()), etag)
+	assert.Equal(t, "console.log('v1')", first.Body.String())
+
+	notModified := serve(t, map[string]string{"If-None-Match": etag})
+	assert.Equal(t, http.StatusNotModified, notModified.Code)
+	assert.Empty(t, notModified.Body.String())
+
+	notModified = serve(t, map[string]string{"If-Modified-Since": modTime.Format(http.TimeFormat)})
+	assert.Equal(t, http.StatusNotModified, notModified.Code)
+
+	modified := serve(t, map[string]string{"If-Modified-Since": modTime.Add(-time.Hour).Format(http.TimeFormat)})
+	assert.Equal(t, http.StatusOK, modified.Code)
+
+	mapFS["app.js"] = &fstest.MapFile{Data: []byte("console.log('v2')"), ModTime: modTime.Add(time.Hour)}
+	changed := serve(t, map[string]string{"If-None-Match": etag})
+	assert.Equal(t, http.StatusOK, changed.Code)
+	assert.NotEqual(t, etag, changed.Header().Get("ETag"))
+	assert.Equal(t, "console.log('v2')", changed.Body.String())
+
+	t.Run("etag_already_set", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/app.js", nil)
+		req.Header.Set("If-None-Match", `"custom"`)
+		recorder := httptest.NewRecorder()
+		recorder.Header().Set("ETag", `"custom"`)
+		require.NoError(t, ServeFile(recorder, req, mapFS, "app.js"))
+		assert.Equal(t, http.StatusNotModified, recorder.Code)
+	})
+}
+
+func TestResolvePrecompressed(t *testing.T) {
+	mapFS := fstest.MapFS{
+		"both.js":     {Data: []byte("both")},
+		"both.js.br":  {Data: []byte("both br")},
+		"both.js.gz":  {Data: []byte("both gz")},
+		"gzip.js":     {Data: []byte("gzip")},
+		"gzip.js.gz":  {Data: []byte("gzip gz")},
+		"none.js":     {Data: []byte("none")},
+		"dir.js":      {Data: []byte("dir")},
+		"dir.js.br/a": {Data: []byte("a")},
+	}
+
+	cases := []struct {
+		file           string
+		acceptEncoding string
+		wantName       string
+		wantEncoding   string
+	}{
+		{file: "both.js", acceptEncoding: "gzip, deflate, br", wantName: "both.js.br", wantEncoding: "br"},
+		{file: "both.js", acceptEncoding: "gzip", wantName: "both.js.gz", wantEncoding: "gzip"},
+		{file: "both.js", acceptEncoding: "br;q=0, gzip;q=0.5", wantName: "both.js.gz", wantEncoding: "gzip"},
+		{file: "both.js", acceptEncoding: "*", wantName: "both.js.br", wantEncoding: "br"},
+		{file: "both.js", acceptEncoding: "*, br;q=0", wantName: "both.js.gz", wantEncoding: "gzip"},
+		{file: "both.js", acceptEncoding: "GZIP;Q=1", wantName: "both.js.gz", wantEncoding: "gzip"},
+		{file: "both.js", acceptEncoding: "", wantName: "both.js", wantEncoding: ""},
+		{file: "both.js", acceptEncoding: "identity", wantName: "both.js", wantEncoding: ""},
+		{file: "both.js", acceptEncoding: "br;q=invalid", wantName: "both.js", wantEncoding: ""},
+		{file: "gzip.js", acceptEncoding: "gzip, br", wantName: "gzip.js.gz", wantEncoding: "gzip"},
+		{file: "none.js", acceptEncoding: "gzip, br", wantName: "none.js", wantEncoding: ""},
+		{file: "dir.js", acceptEncoding: "br", wantName: "dir.js", wantEncoding: ""},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%s_%s", c.file, c.acceptEncoding), func(t *testing.T) {
+			name, encoding := ResolvePrecompressed(mapFS, c.file, c.acceptEncoding)
+			assert.Equal(t, c.wantName, name)
+			assert.Equal(t, c.wantEncoding, encoding)
+		})
+	}
+}
+
+func TestServePrecompressedFile(t *testing.T) {
+	mapFS := fstest.MapFS{
+		"app.js":     {Data: []byte("console.log('app')")},
+		"app.js.br":  {Data: []byte("brotli content")},
+		"app.js.gz":  {Data: []byte("gzip content")},
+		"style.css":  {Data: []byte("body{}")},
+		"only.js.gz": {Data: []byte("gzip content")},
+	}
+
+	serve := func(t *testing.T, file, acceptEncoding string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/"+file, nil)
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+		recorder := httptest.NewRecorder()
+		require.NoError(t, ServePrecompressedFile(recorder, req, mapFS, file))
+		return recorder
+	}
+
+	t.Run("brotli_preferred", func(t *testing.T) {
+		recorder := serve(t, "app.js", "gzip, deflate, br")
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "br", recorder.Header().Get("Content-Encoding"))
+		assert.Equal(t, "text/javascript", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, "Accept-Encoding", recorder.Header().Get("Vary"))
+		assert.Equal(t, "brotli content", recorder.Body.String())
+	})
+
+	t.Run("gzip_fallback", func(t *testing.T) {
+		recorder := serve(t, "app.js", "gzip")
+		assert.Equal(t, "gzip", recorder.Header().Get("Content-Encoding"))
+		assert.Equal(t, "gzip content", recorder.Body.String())
+	})
+
+	t.Run("no_variant", func(t *testing.T) {
+		recorder := serve(t, "style.css", "gzip, br")
+		assert.Empty(t, recorder.Header().Get("Content-Encoding"))
+		assert.Equal(t, "text/css", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, "Accept-Encoding", recorder.Header().Get("Vary"))
+		assert.Equal(t, "body{}", recorder.Body.String())
+	})
+
+	t.Run("not_accepted", func(t *testing.T) {
+		recorder := serve(t, "app.js", "")
+		assert.Empty(t, recorder.Header().Get("Content-Encoding"))
+		assert.Equal(t, "console.log('app')", recorder.Body.String())
+	})
+
+	t.Run("only_compressed_variant", func(t *testing.T) {
+		recorder := serve(t, "only.js", "gzip")
+		assert.Equal(t, "gzip", recorder.Header().Get("Content-Encoding"))
+		assert.Equal(t, "text/javascript", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, "gzip content", recorder.Body.String())
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/only.js", nil)
+		err := ServePrecompressedFile(httptest.NewRecorder(), req, mapFS, "only.js")
+		require.ErrorIs(t, err, fs.ErrNotExist)
+	})
+}
+
+func TestWeakETag(t *testing.T) {
+	mapFS := fstest.MapFS{
+		"a.txt":   {Data: []byte("content a"), ModTime: time.Unix(1700000000, 0)},
+		"b.txt":   {Data: []byte("content a")}, // Zero ModTime, like embedded files
+		"c.txt":   {Data: []byte("content c")},
+		"bis.txt": {Data: []byte("content a")},
+	}
+	etag := func(name string) string {
+		f, err := mapFS.Open(name)
+		require.NoError(t, err)
+		defer func() {
+			assert.NoError(t, f.Close())
+		}()
+		info, err := f.Stat()
+		require.NoError(t, err)
+		tag, err := WeakETag(info, f.(io.ReadSeeker))
+		require.NoError(t, err)
+
+		// The offset is reset
+		content, err := io.ReadAll(f)
+		require.NoError(t, err)
+		assert.Len(t, content, 9)
+		return tag
+	}
+
+	assert.Equal(t, `W/"9-17979cfe362a0000"`, etag("a.txt"))
+	assert.Regexp(t, `^W/"9-[0-9a-f]+"$`, etag("b.txt"))
+	assert.Equal(t, etag("b.txt"), etag("bis.txt"))
+	assert.NotEqual(t, etag("b.txt"), etag("c.txt"))
+}
+
+func createTestZip(t *testing.T, files map[string][]byte) *bytes.Reader {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	writer := zip.NewWriter(buf)
+	names := lo.Keys(files)
+	slices.Sort(names)
+	for _, name := range names {
+		w, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write(files[name])
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestInspectZip(t *testing.T) {
+	t.Run("benign", func(t *testing.T) {
+		r := createTestZip(t, map[string][]byte{
+			"a.txt":       []byte("hello world"),
+			"dir/b.txt":   []byte("some other content"),
+			"empty.txt":   {},
+			"random.data": {0x8f, 0x1a, 0x42, 0xd3, 0x07, 0xe9, 0x5c, 0x33},
+		})
+		require.NoError(t, InspectZip(r, r.Size(), 1024, 10))
+	})
+
+	t.Run("high_ratio", func(t *testing.T) {
+		r := createTestZip(t, map[string][]byte{
+			"a.txt":    []byte("hello world"),
+			"bomb.txt": bytes.Repeat([]byte{0}, 1<<20),
+		})
+		err := InspectZip(r, r.Size(), 10<<20, 100)
+		require.ErrorIs(t, err, ErrZipBomb)
+		assert.Contains(t, err.Error(), `"bomb.txt"`)
+	})
+
+	t.Run("total_size", func(t *testing.T) {
+		r := createTestZip(t, map[string][]byte{
+			"a.txt": bytes.Repeat([]byte("a"), 600),
+			"b.txt": bytes.Repeat([]byte("b"), 600),
+		})
+		require.NoError(t, InspectZip(r, r.Size(), 1200, 1000))
+		err := InspectZip(r, r.Size(), 1000, 1000)
+		require.ErrorIs(t, err, ErrZipBomb)
+	})
+
+	t.Run("not_a_zip", func(t *testing.T) {
+		r := bytes.NewReader([]byte("not a zip archive"))
+		err := InspectZip(r, r.Size(), 1024, 10)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrZipBomb)
+	})
+}
+
+func TestListDir(t *testing.T) {
+	modTime := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	mapFS := fstest.MapFS{
+		"dir/script.js":          {Data: []byte("console.log('hello')"), ModTime: modTime},
+		"dir/sub/file.txt":       {Data: []byte("content")},
+		"dir/image.png":          {Data: []byte("\x89PNG\x0D\x0A\x1A\x0A"), ModTime: modTime},
+		"other/not_listed.txt":   {Data: []byte("content")},
+		"dir/sub/not_listed.txt": {Data: []byte("content")},
+	}
+
+	t.Run("list", func(t *testing.T) {
+		list, err := ListDir(mapFS, "dir")
+		require.NoError(t, err)
+		require.Len(t, list, 3)
+
+		assert.Equal(t, FileInfo{Name: "image.png", Size: 8, ModTime: modTime, MIMEType: "image/png"}, list[0])
+		assert.Equal(t, FileInfo{Name: "script.js", Size: 20, ModTime: modTime, MIMEType: "text/javascript"}, list[1])
+		assert.Equal(t, "sub", list[2].Name)
+		assert.True(t, list[2].IsDir)
+		assert.Empty(t, list[2].MIMEType)
+
+		res, err := json.Marshal(list[0])
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"image.png","size":8,"modTime":"2024-03-01T12:00:00Z","isDir":false,"mimeType":"image/png"}`, string(res))
+	})
+
+	t.Run("embed", func(t *testing.T) {
+		list, err := ListDir(NewEmbed(resources), ".")
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, "osfs", list[0].Name)
+		assert.True(t, list[0].IsDir)
+
+		list, err = ListDir(NewEmbed(resources), "osfs")
+		require.NoError(t, err)
+		require.NotEmpty(t, list)
+		for _, info := range list {
+			assert.False(t, info.IsDir)
+			assert.Positive(t, info.Size)
+			assert.Equal(t, "text/plain; charset=utf-8", info.MIMEType)
+		}
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		list, err := ListDir(mapFS, "notadir")
+		require.Error(t, err)
+		assert.Nil(t, list)
+	})
+}
+
 func TestMarshalFile(t *testing.T) {
 	type testDTO struct {
 		Files []File `json:"files"`
@@ -613,6 +1467,355 @@ func (e mockFS) ReadDir(_ string) ([]fs.DirEntry, error) {
 	return []fs.DirEntry{&mockDirEntry{}}, nil
 }
 
+type countingFS struct {
+	FS
+	open    atomic.Int64
+	stat    atomic.Int64
+	readDir atomic.Int64
+}
+
+func (f *countingFS) Open(name string) (fs.File, error) {
+	f.open.Add(1)
+	return f.FS.Open(name)
+}
+
+func (f *countingFS) Stat(name string) (fs.FileInfo, error) {
+	f.stat.Add(1)
+	return f.FS.Stat(name)
+}
+
+func (f *countingFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	f.readDir.Add(1)
+	return f.FS.ReadDir(name)
+}
+
+func TestCachedFS(t *testing.T) {
+	newCachedFS := func() (*CachedFS, *countingFS, *time.Time) {
+		inner := &countingFS{FS: fstest.MapFS{
+			"dir/a.txt": {Data: []byte("a")},
+			"dir/b.txt": {Data: []byte("b")},
+		}}
+		now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+		cached := NewCachedFS(inner, time.Minute)
+		cached.now = func() time.Time { return now }
+		return cached, inner, &now
+	}
+
+	t.Run("Stat", func(t *testing.T) {
+		cached, inner, now := newCachedFS()
+		info, err := cached.Stat("dir/a.txt")
+		require.NoError(t, err)
+		assert.Equal(t, "a.txt", info.Name())
+		assert.Equal(t, int64(1), inner.stat.Load())
+
+		info, err = cached.Stat("dir/a.txt")
+		require.NoError(t, err)
+		assert.Equal(t, "a.txt", info.Name())
+		assert.Equal(t, int64(1), inner.stat.Load())
+
+		*now = now.Add(time.Minute)
+		_, err = cached.Stat("dir/a.txt")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), inner.stat.Load())
+
+		_, err = cached.Stat("dir/b.txt")
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), inner.stat.Load())
+	})
+
+	t.Run("Stat_error_not_cached", func(t *testing.T) {
+		cached, inner, _ := newCachedFS()
+		_, err := cached.Stat("notafile")
+		require.ErrorIs(t, err, fs.ErrNotExist)
+		_, err = cached.Stat("notafile")
+		require.ErrorIs(t, err, fs.ErrNotExist)
+		assert.Equal(t, int64(2), inner.stat.Load())
+	})
+
+	t.Run("ReadDir", func(t *testing.T) {
+		cached, inner, now := newCachedFS()
+		entries, err := cached.ReadDir("dir")
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		entries[0] = nil // Modifying the result doesn't affect the cache
+
+		entries, err = cached.ReadDir("dir")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a.txt", "b.txt"}, lo.Map(entries, func(e fs.DirEntry, _ int) string { return e.Name() }))
+		assert.Equal(t, int64(1), inner.readDir.Load())
+
+		*now = now.Add(2 * time.Minute)
+		_, err = cached.ReadDir("dir")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), inner.readDir.Load())
+
+		_, err = cached.ReadDir("notadir")
+		require.Error(t, err)
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		cached, inner, _ := newCachedFS()
+		_, err := cached.Stat("dir/a.txt")
+		require.NoError(t, err)
+		_, err = cached.ReadDir("dir")
+		require.NoError(t, err)
+
+		cached.Clear()
+		_, err = cached.Stat("dir/a.txt")
+		require.NoError(t, err)
+		_, err = cached.ReadDir("dir")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), inner.stat.Load())
+		assert.Equal(t, int64(2), inner.readDir.Load())
+	})
+
+	t.Run("Open", func(t *testing.T) {
+		cached, _, _ := newCachedFS()
+		f, err := cached.Open("dir/a.txt")
+		require.NoError(t, err)
+		content, err := io.ReadAll(f)
+		require.NoError(t, err)
+		assert.Equal(t, "a", string(content))
+		assert.NoError(t, f.Close())
+	})
+
+	t.Run("concurrency", func(t *testing.T) {
+		cached, _, _ := newCachedFS()
+		wg := sync.WaitGroup{}
+		for range 10 {
+			wg.Go(func() {
+				_, _ = cached.Stat("dir/a.txt")
+				_, _ = cached.ReadDir("dir")
+				cached.Clear()
+			})
+		}
+		wg.Wait()
+	})
+}
+
+func TestCachingMIMEDetector(t *testing.T) {
+	modTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mapFS := fstest.MapFS{
+		"index.html": {Data: []byte("<!DOCTYPE html><html></html>"), ModTime: modTime},
+		"embedded":   {Data: []byte("%PDF-1.4")},
+	}
+	inner := &countingFS{FS: mapFS}
+	detector := NewCachingMIMEDetector()
+
+	contentType, size, err := detector.GetMIMEType(inner, "index.html")
+	require.NoError(t, err)
+	assert.Equal(t, "text/html; charset=utf-8", contentType)
+	assert.Equal(t, int64(28), size)
+	assert.Equal(t, int64(1), inner.open.Load())
+
+	// Cache hit: the file is not opened again
+	contentType, size, err = detector.GetMIMEType(inner, "index.html")
+	require.NoError(t, err)
+	assert.Equal(t, "text/html; charset=utf-8", contentType)
+	assert.Equal(t, int64(28), size)
+	assert.Equal(t, int64(1), inner.open.Load())
+
+	// Modification time changed: detected again
+	mapFS["index.html"] = &fstest.MapFile{Data: []byte("%PDF-1.4 <html>"), ModTime: modTime.Add(time.Second)}
+	contentType, size, err = detector.GetMIMEType(inner, "index.html")
+	require.NoError(t, err)
+	assert.Equal(t, "application/pdf", contentType)
+	assert.Equal(t, int64(15), size)
+	assert.Equal(t, int64(2), inner.open.Load())
+
+	// Size changed with the same modification time: detected again
+	mapFS["index.html"] = &fstest.MapFile{Data: []byte("%PDF-1.4"), ModTime: modTime.Add(time.Second)}
+	_, size, err = detector.GetMIMEType(inner, "index.html")
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), size)
+	assert.Equal(t, int64(3), inner.open.Load())
+
+	// Zero modification time
+	for range 2 {
+		contentType, _, err = detector.GetMIMEType(inner, "embedded")
+		require.NoError(t, err)
+		assert.Equal(t, "application/pdf", contentType)
+	}
+	assert.Equal(t, int64(4), inner.open.Load())
+
+	detector.Clear()
+	_, _, err = detector.GetMIMEType(inner, "index.html")
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), inner.open.Load())
+
+	t.Run("not_found", func(t *testing.T) {
+		_, _, err := detector.GetMIMEType(inner, "notafile")
+		require.ErrorIs(t, err, fs.ErrNotExist)
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		detector := NewCachingMIMEDetector()
+		wg := sync.WaitGroup{}
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				contentType, _, err := detector.GetMIMEType(mapFS, "embedded")
+				assert.NoError(t, err)
+				assert.Equal(t, "application/pdf", contentType)
+			}()
+		}
+		wg.Wait()
+	})
+}
+
+func TestReadOnly(t *testing.T) {
+	inner := osfs.New(t.TempDir())
+	require.NoError(t, inner.MkdirAll("dir", 0770))
+	f, err := inner.OpenFile("dir/file.txt", os.O_WRONLY|os.O_CREATE, 0660)
+	require.NoError(t, err)
+	_, err = f.Write([]byte("content"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	readOnly := ReadOnly(inner)
+
+	file, err := readOnly.Open("dir/file.txt")
+	require.NoError(t, err)
+	content, err := io.ReadAll(file)
+	require.NoError(t, err)
+	assert.Equal(t, "content", string(content))
+	require.NoError(t, file.Close())
+
+	info, err := readOnly.Stat("dir/file.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "file.txt", info.Name())
+
+	entries, err := readOnly.ReadDir("dir")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "file.txt", entries[0].Name())
+
+	_, err = readOnly.Open("notafile")
+	require.ErrorIs(t, err, fs.ErrNotExist)
+
+	assert.Implements(t, (*WritableFS)(nil), inner)
+	_, ok := readOnly.(WritableFS)
+	assert.False(t, ok)
+	_, ok = readOnly.(MkdirFS)
+	assert.False(t, ok)
+	_, ok = readOnly.(RemoveFS)
+	assert.False(t, ok)
+	_, ok = readOnly.(RenameFS)
+	assert.False(t, ok)
+}
+
+func TestSub(t *testing.T) {
+	dir := t.TempDir()
+	inner := osfs.New(dir)
+	require.NoError(t, inner.MkdirAll("public/css", 0770))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "public/css/style.css"), []byte("body{}"), 0660))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "secret.txt"), []byte("secret"), 0660))
+
+	sub, err := Sub(inner, "public")
+	require.NoError(t, err)
+
+	t.Run("read", func(t *testing.T) {
+		f, err := sub.Open("css/style.css")
+		require.NoError(t, err)
+		content, err := io.ReadAll(f)
+		require.NoError(t, err)
+		assert.Equal(t, "body{}", string(content))
+		require.NoError(t, f.Close())
+
+		entries, err := sub.ReadDir(".")
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "css", entries[0].Name())
+	})
+
+	t.Run("stat", func(t *testing.T) {
+		info, err := sub.Stat("css/style.css")
+		require.NoError(t, err)
+		assert.Equal(t, "style.css", info.Name())
+		assert.True(t, IsDirectory(sub, "css"))
+
+		_, err = sub.Stat("secret.txt")
+		require.ErrorIs(t, err, fs.ErrNotExist)
+	})
+
+	t.Run("traversal", func(t *testing.T) {
+		for _, name := range []string{"../secret.txt", "css/../../secret.txt", "/secret.txt", ".."} {
+			_, err := sub.Open(name)
+			require.ErrorIs(t, err, fs.ErrInvalid, name)
+			_, err = sub.Stat(name)
+			require.ErrorIs(t, err, fs.ErrInvalid, name)
+			_, err = sub.ReadDir(name)
+			require.ErrorIs(t, err, fs.ErrInvalid, name)
+		}
+		var pathErr *fs.PathError
+		_, err := sub.Open("../secret.txt")
+		require.ErrorAs(t, err, &pathErr)
+		assert.Equal(t, "open", pathErr.Op)
+		assert.Equal(t, "../secret.txt", pathErr.Path)
+	})
+
+	t.Run("write", func(t *testing.T) {
+		writable, ok := sub.(WritableFS)
+		require.True(t, ok)
+		mkdirFS, ok := sub.(MkdirFS)
+		require.True(t, ok)
+		removeFS, ok := sub.(RemoveFS)
+		require.True(t, ok)
+		renameFS, ok := sub.(RenameFS)
+		require.True(t, ok)
+
+		require.NoError(t, mkdirFS.MkdirAll("js/vendor", 0770))
+		require.NoError(t, mkdirFS.Mkdir("img", 0770))
+		assert.True(t, IsDirectory(inner, "public/js/vendor"))
+		assert.True(t, IsDirectory(inner, "public/img"))
+
+		f, err := writable.OpenFile("js/app.js", os.O_WRONLY|os.O_CREATE, 0660)
+		require.NoError(t, err)
+		_, err = f.Write([]byte("app"))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		content, err := os.ReadFile(filepath.Join(dir, "public/js/app.js"))
+		require.NoError(t, err)
+		assert.Equal(t, "app", string(content))
+
+		require.NoError(t, renameFS.Rename("js/app.js", "js/vendor/app.js"))
+		assert.True(t, FileExists(inner, "public/js/vendor/app.js"))
+
+		require.NoError(t, removeFS.Remove("img"))
+		assert.False(t, IsDirectory(inner, "public/img"))
+		require.NoError(t, removeFS.RemoveAll("js"))
+		assert.False(t, IsDirectory(inner, "public/js"))
+
+		_, err = writable.OpenFile("../evil.txt", os.O_WRONLY|os.O_CREATE, 0660)
+		require.ErrorIs(t, err, fs.ErrInvalid)
+		require.ErrorIs(t, mkdirFS.MkdirAll("../evil", 0770), fs.ErrInvalid)
+		require.ErrorIs(t, mkdirFS.Mkdir("../evil", 0770), fs.ErrInvalid)
+		require.ErrorIs(t, removeFS.Remove("../secret.txt"), fs.ErrInvalid)
+		require.ErrorIs(t, removeFS.RemoveAll(".."), fs.ErrInvalid)
+		require.ErrorIs(t, renameFS.Rename("../secret.txt", "secret.txt"), fs.ErrInvalid)
+		require.ErrorIs(t, renameFS.Rename("css/style.css", "../style.css"), fs.ErrInvalid)
+		assert.True(t, FileExists(inner, "secret.txt"))
+	})
+
+	t.Run("read_only_inner", func(t *testing.T) {
+		sub, err := Sub(fstest.MapFS{"dir/file.txt": {Data: []byte("content")}}, "dir")
+		require.NoError(t, err)
+		assert.True(t, FileExists(sub, "file.txt"))
+		_, ok := sub.(WritableFS)
+		assert.False(t, ok)
+	})
+
+	t.Run("invalid_dir", func(t *testing.T) {
+		_, err := Sub(inner, "../")
+		require.ErrorIs(t, err, fs.ErrInvalid)
+
+		same, err := Sub(inner, ".")
+		require.NoError(t, err)
+		assert.Same(t, inner, same)
+	})
+}
+
 func TestEmbed(t *testing.T) {
 	e := NewEmbed(resources)
 
@@ -0,0 +1,60 @@
+package fsutil
+
+import (
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"goyave.dev/goyave/v5/util/errors"
+)
+
+// FileInfo describes a directory entry returned by `ListDir()`.
+// It is meant to be serialized to JSON.
+type FileInfo struct {
+	ModTime time.Time `json:"modTime"`
+	Name    string    `json:"name"`
+	// MIMEType the detected MIME type of the file (see `GetMIMEType()`).
+	// Empty for directories.
+	MIMEType string `json:"mimeType,omitempty"`
+	Size     int64  `json:"size"`
+	IsDir    bool   `json:"isDir"`
+}
+
+// ListDir reads the given directory and returns the information of all its entries,
+// sorted by name. This is useful for file browsers for example.
+//
+// The MIME type of each file is detected using `GetMIMEType()`, which means all the files
+// of the directory are opened. Beware of large directories.
+func ListDir(fsys fs.ReadDirFS, dir string) ([]FileInfo, error) {
+	entries, err := fsys.ReadDir(dir)
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	list := make([]FileInfo, 0, len(entries))
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			return nil, errors.New(err)
+		}
+		fileInfo := FileInfo{
+			Name:    entry.Name(),
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+			IsDir:   entry.IsDir(),
+		}
+		if !fileInfo.IsDir {
+			fileInfo.MIMEType, _, err = GetMIMEType(fsys, path.Join(dir, entry.Name()))
+			if err != nil {
+				return nil, errors.New(err)
+			}
+		}
+		list = append(list, fileInfo)
+	}
+	slices.SortFunc(list, func(a, b FileInfo) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return list, nil
+}
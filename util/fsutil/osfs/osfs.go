@@ -118,6 +118,14 @@ func (f *FS) RemoveAll(name string) error {
 	return errors.NewSkip(os.RemoveAll(path.Join(f.dir, name)), 3)
 }
 
+// Rename renames (moves) oldpath to newpath.
+// If newpath already exists and is not a directory, Rename replaces it.
+// OS-specific restrictions may apply when oldpath and newpath are in different directories.
+// If there is an error, it will be of type `*LinkError`.
+func (f *FS) Rename(oldpath, newpath string) error {
+	return errors.NewSkip(os.Rename(path.Join(f.dir, oldpath), path.Join(f.dir, newpath)), 3)
+}
+
 // Sub returns an `*osfs.FS` corresponding to the subtree rooted at this fs's dir.
 // If dir is ".", the same `&osfs.FS` is returned.
 //
@@ -153,6 +153,33 @@ func TestOSFS(t *testing.T) {
 		assert.False(t, fs.IsDirectory("resources/testdirremoveall"))
 	})
 
+	t.Run("Rename", func(t *testing.T) {
+		fs := New(t.TempDir())
+		require.NoError(t, fs.MkdirAll("subdir", 0770))
+		file, err := fs.OpenFile("file.txt", os.O_WRONLY|os.O_CREATE, 0660)
+		require.NoError(t, err)
+		_, err = file.Write([]byte("content"))
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+
+		require.NoError(t, fs.Rename("file.txt", "renamed.txt"))
+		_, err = fs.Stat("file.txt")
+		require.ErrorIs(t, err, os.ErrNotExist)
+		content, err := os.ReadFile(path.Join(fs.dir, "renamed.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "content", string(content))
+
+		// Across directories
+		require.NoError(t, fs.Rename("renamed.txt", "subdir/renamed.txt"))
+		_, err = fs.Stat("renamed.txt")
+		require.ErrorIs(t, err, os.ErrNotExist)
+		content, err = os.ReadFile(path.Join(fs.dir, "subdir", "renamed.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "content", string(content))
+
+		require.Error(t, fs.Rename("file.txt", "other.txt"))
+	})
+
 	t.Run("Sub", func(t *testing.T) {
 		f, err := (&FS{}).Sub("resources")
 		require.NoError(t, err)
@@ -0,0 +1,36 @@
+package fsutil
+
+import "io/fs"
+
+// readOnlyFS only exposes the read methods of a `FS`. See `ReadOnly()`.
+type readOnlyFS struct {
+	inner FS
+}
+
+// ReadOnly returns a `FS` wrapping the given file system but only exposing its read
+// methods (`Open()`, `ReadDir()` and `Stat()`). The returned file system doesn't
+// implement `WritableFS`, `MkdirFS`, `RemoveFS` nor `RenameFS`, even if the inner
+// file system does, so it cannot accidentally be used to write (when serving user
+// content for example).
+//
+// The files are opened using the inner file system's `Open()` method, which should
+// open them in read-only mode.
+func ReadOnly(inner FS) FS {
+	return &readOnlyFS{inner: inner}
+}
+
+// Open opens the named file for reading.
+func (f *readOnlyFS) Open(name string) (fs.File, error) {
+	return f.inner.Open(name)
+}
+
+// ReadDir reads the named directory
+// and returns a list of directory entries sorted by filename.
+func (f *readOnlyFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	return f.inner.ReadDir(name)
+}
+
+// Stat returns a FileInfo describing the file.
+func (f *readOnlyFS) Stat(name string) (fs.FileInfo, error) {
+	return f.inner.Stat(name)
+}
@@ -0,0 +1,183 @@
+package fsutil
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"io/fs"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"goyave.dev/goyave/v5/util/errors"
+)
+
+// ServeFile writes the content of the given file to the response, honoring the "Range" request
+// header so clients can seek in media files or resume downloads:
+//   - the "Accept-Ranges: bytes" header is always set
+//   - if a satisfiable range is requested, only the requested range(s) are written with
+//     the status 206 Partial Content and the "Content-Range" header
+//   - if the range is not satisfiable, the status 416 Requested Range Not Satisfiable is written
+//   - if no range is requested, the full content is written with the status 200 OK
+//
+// The "Content-Type" header is set using `GetMIMEType()` if it is not already set.
+// The "ETag" header is set using `WeakETag()` if it is not already set, and the "Last-Modified"
+// header is set from the file's modification time (if not zero). The conditional request headers
+// ("If-None-Match", "If-Modified-Since", "If-Range", etc.) are handled by `http.ServeContent()`:
+// the status 304 Not Modified is written without body if the client's cached version is still valid.
+//
+// If the file doesn't implement `io.Seeker`, its whole content is read in memory.
+// Returns an error without writing anything if the file cannot be opened or is a directory.
+func ServeFile(w http.ResponseWriter, r *http.Request, filesystem fs.FS, file string) (err error) {
+	var f fs.File
+	f, err = filesystem.Open(file)
+	if err != nil {
+		return errors.New(err)
+	}
+	defer func() {
+		closeError := f.Close()
+		if err == nil && closeError != nil {
+			err = errors.New(closeError)
+		}
+	}()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return errors.New(err)
+	}
+	if stat.IsDir() {
+		return errors.Errorf("fsutil.ServeFile: %q is a directory", file)
+	}
+
+	if w.Header().Get("Content-Type") == "" {
+		contentType, _, err := GetMIMEType(filesystem, file)
+		if err != nil {
+			return err
+		}
+		w.Header().Set("Content-Type", contentType)
+	}
+
+	content, ok := f.(io.ReadSeeker)
+	if !ok {
+		data, err := io.ReadAll(f)
+		if err != nil {
+			return errors.New(err)
+		}
+		content = bytes.NewReader(data)
+	}
+
+	if w.Header().Get("ETag") == "" {
+		etag, err := WeakETag(stat, content)
+		if err != nil {
+			return err
+		}
+		w.Header().Set("ETag", etag)
+	}
+
+	http.ServeContent(w, r, stat.Name(), stat.ModTime(), content)
+	return nil
+}
+
+// WeakETag returns a weak entity tag (e.g. `W/"a-18c7e3a1f5b2c400"`) identifying the current version
+// of a file, for use in the "ETag" response header. It is computed from the size and modification
+// time of the file. If the modification time is zero (which is the case for files embedded using
+// `embed.FS`), the tag is computed from a hash of the content instead, then the content's offset
+// is reset to the start.
+func WeakETag(info fs.FileInfo, content io.ReadSeeker) (string, error) {
+	if !info.ModTime().IsZero() {
+		return fmt.Sprintf(`W/"%x-%x"`, info.Size(), info.ModTime().UnixNano()), nil
+	}
+
+	h := fnv.New64a()
+	if _, err := io.Copy(h, content); err != nil {
+		return "", errors.New(err)
+	}
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return "", errors.New(err)
+	}
+	return fmt.Sprintf(`W/"%x-%x"`, info.Size(), h.Sum64()), nil
+}
+
+// precompressedEncodings the supported pre-compressed variants, by order of preference.
+var precompressedEncodings = []struct {
+	encoding  string
+	extension string
+}{
+	{encoding: "br", extension: ".br"},
+	{encoding: "gzip", extension: ".gz"},
+}
+
+// ResolvePrecompressed returns the name of the best pre-compressed variant of the given file
+// accepted by the client according to the given "Accept-Encoding" header value, and its
+// content coding. A brotli-compressed sibling file (e.g. "app.js.br") is preferred over a
+// gzip-compressed one (e.g. "app.js.gz").
+//
+// If no accepted variant exists, returns the given file name and an empty encoding.
+func ResolvePrecompressed(filesystem fs.FS, file, acceptEncoding string) (name string, encoding string) {
+	accepted := parseAcceptEncoding(acceptEncoding)
+	for _, e := range precompressedEncodings {
+		if !accepted(e.encoding) {
+			continue
+		}
+		variant := file + e.extension
+		if stat, err := fs.Stat(filesystem, variant); err == nil && !stat.IsDir() {
+			return variant, e.encoding
+		}
+	}
+	return file, ""
+}
+
+// parseAcceptEncoding returns a function telling if the given content coding
+// is accepted according to the given "Accept-Encoding" header value.
+func parseAcceptEncoding(header string) func(encoding string) bool {
+	qualities := map[string]bool{}
+	for part := range strings.SplitSeq(header, ",") {
+		coding, params, _ := strings.Cut(part, ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding == "" {
+			continue
+		}
+		accepted := true
+		for param := range strings.SplitSeq(params, ";") {
+			key, value, _ := strings.Cut(param, "=")
+			if strings.TrimSpace(key) == "q" {
+				q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+				accepted = err == nil && q > 0
+			}
+		}
+		qualities[coding] = accepted
+	}
+	return func(encoding string) bool {
+		if accepted, ok := qualities[encoding]; ok {
+			return accepted
+		}
+		return qualities["*"]
+	}
+}
+
+// ServePrecompressedFile is the same as `ServeFile()` but serves the best pre-compressed variant
+// of the file accepted by the client if it exists (see `ResolvePrecompressed()`), and sets
+// the "Content-Encoding" header accordingly. This is useful for static assets compressed at
+// build time, for example single-page applications embedded using `embed.FS`.
+//
+// The "Content-Type" header is set from the original (uncompressed) file, using `GetMIMEType()`
+// if it exists or `DetectContentTypeByExtension()` otherwise. The "Vary: Accept-Encoding"
+// header is always added.
+func ServePrecompressedFile(w http.ResponseWriter, r *http.Request, filesystem fs.FS, file string) error {
+	w.Header().Add("Vary", "Accept-Encoding")
+	name, encoding := ResolvePrecompressed(filesystem, file, r.Header.Get("Accept-Encoding"))
+	if encoding == "" {
+		return ServeFile(w, r, filesystem, file)
+	}
+
+	if w.Header().Get("Content-Type") == "" {
+		contentType, _, err := GetMIMEType(filesystem, file)
+		if err != nil {
+			contentType = DetectContentTypeByExtension(file)
+		}
+		w.Header().Set("Content-Type", contentType)
+	}
+	w.Header().Set("Content-Encoding", encoding)
+	return ServeFile(w, r, filesystem, name)
+}
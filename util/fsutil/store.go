@@ -0,0 +1,117 @@
+package fsutil
+
+import (
+	"encoding/hex"
+	stderrors "errors"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+
+	"github.com/google/uuid"
+	"goyave.dev/goyave/v5/util/errors"
+)
+
+// A HashStoreFS is a file system supporting the operations required by `StoreByHash()`.
+type HashStoreFS interface {
+	fs.StatFS
+	WritableFS
+	MkdirFS
+	RemoveFS
+	RenameFS
+}
+
+// StoreByHash stores the content of the given reader in a content-addressable way: the file
+// path is derived from the hexadecimal digest of the content computed using the given hash.
+// The file is sharded in two levels of sub-directories to avoid directories containing too
+// many files: a content whose digest is "abcdef..." is stored at "<baseDir>/ab/cd/abcdef...".
+//
+// The content is streamed to a temporary file in "baseDir" while being hashed, then
+// the temporary file is renamed to its final path. If a file already exists at this path,
+// the temporary file is removed and "existed" is true. This way, identical contents are only
+// stored once.
+//
+// The given hash is reset before use. Returns the path of the stored file, relative to the
+// root of the file system.
+func StoreByHash(dst HashStoreFS, baseDir string, r io.Reader, h hash.Hash) (filePath string, existed bool, err error) {
+	if err = dst.MkdirAll(baseDir, os.ModePerm); err != nil {
+		return "", false, errors.New(err)
+	}
+
+	tmpPath := path.Join(baseDir, ".tmp-"+uuid.NewString())
+	defer func() {
+		if err != nil || existed {
+			if removeErr := dst.Remove(tmpPath); removeErr != nil && !stderrors.Is(removeErr, fs.ErrNotExist) {
+				err = errors.New([]error{err, removeErr})
+			}
+		}
+	}()
+
+	h.Reset()
+	if err = writeFile(dst, tmpPath, io.TeeReader(r, h)); err != nil {
+		return "", false, err
+	}
+
+	digest := hex.EncodeToString(h.Sum(nil))
+	if len(digest) < 4 {
+		return "", false, errors.Errorf("fsutil.StoreByHash: digest %q is too short", digest)
+	}
+	filePath = path.Join(baseDir, digest[:2], digest[2:4], digest)
+
+	if _, statErr := dst.Stat(filePath); statErr == nil {
+		return filePath, true, nil
+	}
+
+	if err = dst.MkdirAll(path.Dir(filePath), os.ModePerm); err != nil {
+		return "", false, errors.New(err)
+	}
+	if err = dst.Rename(tmpPath, filePath); err != nil {
+		return "", false, errors.New(err)
+	}
+	return filePath, false, nil
+}
+
+// SaveAndHash writes the content of the given reader to a new file in "dir" while
+// computing its digest using the given hash, so the content is only read once.
+// The file is named after a random UUID. The directory is created if it doesn't
+// exist and if the file system implements `MkdirFS`.
+//
+// The given hash is reset before use. Returns the name of the created file (relative to "dir")
+// and the hexadecimal digest of its content. If an error occurs, the partially written
+// file is not removed.
+//
+// Use `StoreByHash()` to name the file after its digest instead.
+func SaveAndHash(dst WritableFS, dir string, r io.Reader, h hash.Hash) (name, digest string, err error) {
+	if mkdirFS, ok := dst.(MkdirFS); ok {
+		if err = mkdirFS.MkdirAll(dir, os.ModePerm); err != nil {
+			return "", "", errors.New(err)
+		}
+	}
+
+	h.Reset()
+	name = uuid.NewString()
+	if err = writeFile(dst, path.Join(dir, name), io.TeeReader(r, h)); err != nil {
+		return "", "", err
+	}
+	return name, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// writeFile creates a new file at the given path and writes the content of the reader in it.
+func writeFile(dst WritableFS, filePath string, r io.Reader) (err error) {
+	var f io.ReadWriteCloser
+	f, err = dst.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0660)
+	if err != nil {
+		return errors.New(err)
+	}
+	defer func() {
+		closeError := f.Close()
+		if err == nil && closeError != nil {
+			err = errors.New(closeError)
+		}
+	}()
+	if _, err = io.Copy(f, r); err != nil {
+		err = errors.New(err)
+	}
+	return
+}
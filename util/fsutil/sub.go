@@ -0,0 +1,151 @@
+package fsutil
+
+import (
+	"io"
+	"io/fs"
+	"path"
+
+	"goyave.dev/goyave/v5/util/errors"
+)
+
+// subFS a `FS` corresponding to the subtree rooted at "dir" in the inner file system.
+// See `Sub()`.
+type subFS struct {
+	inner FS
+	dir   string
+}
+
+// writableSubFS a `subFS` also implementing `WritableFS`, `MkdirFS`, `RemoveFS` and `RenameFS`.
+type writableSubFS struct {
+	*subFS
+}
+
+type writableFS interface {
+	FS
+	WritableFS
+	MkdirFS
+	RemoveFS
+	RenameFS
+}
+
+// Sub returns a `FS` corresponding to the subtree rooted at "dir" in the given file system.
+// Unlike `fs.Sub()`, the returned file system implements `fs.StatFS` and `fs.ReadDirFS`. If the
+// inner file system implements all of `WritableFS`, `MkdirFS`, `RemoveFS` and `RenameFS`
+// (such as `*osfs.FS`), the returned file system implements them too.
+//
+// All the paths given to the returned file system must satisfy `fs.ValidPath()`, which
+// prevents escaping the subtree (with ".." for example). Otherwise, a `*fs.PathError`
+// wrapping `fs.ErrInvalid` is returned.
+//
+// Returns an error if "dir" doesn't satisfy `fs.ValidPath()`.
+// If "dir" is ".", the inner file system is returned unchanged.
+func Sub(inner FS, dir string) (FS, error) {
+	if !fs.ValidPath(dir) {
+		return nil, errors.NewSkip(&fs.PathError{Op: "sub", Path: dir, Err: fs.ErrInvalid}, 3)
+	}
+	if dir == "." {
+		return inner, nil
+	}
+	sub := &subFS{inner: inner, dir: dir}
+	if _, ok := inner.(writableFS); ok {
+		return writableSubFS{subFS: sub}, nil
+	}
+	return sub, nil
+}
+
+// fullName returns the name of the file in the inner file system.
+func (f *subFS) fullName(op, name string) (string, error) {
+	if !fs.ValidPath(name) {
+		return "", errors.NewSkip(&fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}, 4)
+	}
+	return path.Join(f.dir, name), nil
+}
+
+// Open opens the named file.
+func (f *subFS) Open(name string) (fs.File, error) {
+	full, err := f.fullName("open", name)
+	if err != nil {
+		return nil, err
+	}
+	return f.inner.Open(full)
+}
+
+// ReadDir reads the named directory
+// and returns a list of directory entries sorted by filename.
+func (f *subFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	full, err := f.fullName("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+	return f.inner.ReadDir(full)
+}
+
+// Stat returns a FileInfo describing the file.
+func (f *subFS) Stat(name string) (fs.FileInfo, error) {
+	full, err := f.fullName("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	return f.inner.Stat(full)
+}
+
+// OpenFile is the generalized open call. It opens the named file with specified flag
+// (`O_RDONLY` etc.). If the file does not exist, and the `O_CREATE` flag
+// is passed, it is created with mode perm (before umask).
+func (f writableSubFS) OpenFile(name string, flag int, perm fs.FileMode) (io.ReadWriteCloser, error) {
+	full, err := f.fullName("open", name)
+	if err != nil {
+		return nil, err
+	}
+	return f.inner.(WritableFS).OpenFile(full, flag, perm)
+}
+
+// MkdirAll creates a directory named path, along with any necessary parents.
+func (f writableSubFS) MkdirAll(name string, perm fs.FileMode) error {
+	full, err := f.fullName("mkdir", name)
+	if err != nil {
+		return err
+	}
+	return f.inner.(MkdirFS).MkdirAll(full, perm)
+}
+
+// Mkdir creates a new directory with the specified name and permission
+// bits (before umask).
+func (f writableSubFS) Mkdir(name string, perm fs.FileMode) error {
+	full, err := f.fullName("mkdir", name)
+	if err != nil {
+		return err
+	}
+	return f.inner.(MkdirFS).Mkdir(full, perm)
+}
+
+// Remove removes the named file or (empty) directory.
+func (f writableSubFS) Remove(name string) error {
+	full, err := f.fullName("remove", name)
+	if err != nil {
+		return err
+	}
+	return f.inner.(RemoveFS).Remove(full)
+}
+
+// RemoveAll removes path and any children it contains.
+func (f writableSubFS) RemoveAll(name string) error {
+	full, err := f.fullName("removeall", name)
+	if err != nil {
+		return err
+	}
+	return f.inner.(RemoveFS).RemoveAll(full)
+}
+
+// Rename renames (moves) oldpath to newpath.
+func (f writableSubFS) Rename(oldpath, newpath string) error {
+	fullOld, err := f.fullName("rename", oldpath)
+	if err != nil {
+		return err
+	}
+	fullNew, err := f.fullName("rename", newpath)
+	if err != nil {
+		return err
+	}
+	return f.inner.(RenameFS).Rename(fullOld, fullNew)
+}
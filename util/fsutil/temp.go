@@ -0,0 +1,117 @@
+package fsutil
+
+import (
+	stderrors "errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	"goyave.dev/goyave/v5/util/errors"
+)
+
+// A TempFS is a file system supporting the operations required by `TempFile()`.
+type TempFS interface {
+	WritableFS
+	RemoveFS
+}
+
+// TempFile creates a new temporary file in the directory "dir", opens it for reading
+// and writing, and returns the file, its path (relative to the root of the file system)
+// and a cleanup function. This is useful to process uploads before committing them.
+//
+// The file name is generated by taking "pattern" and adding a random string to the end.
+// If "pattern" includes a "*", the random string replaces the last "*". The directory is
+// created if it doesn't exist and if the file system implements `MkdirFS`.
+//
+// The cleanup function closes the file (if it is not closed already) and removes it.
+// It should be deferred by the caller. The caller is responsible for removing the file
+// if it is not needed anymore, so the file is kept if the cleanup function is not called
+// (after renaming the file for example, the cleanup function should not be called).
+func TempFile(dst TempFS, dir, pattern string) (file io.ReadWriteCloser, filePath string, cleanup func() error, err error) {
+	if strings.Contains(pattern, "/") {
+		return nil, "", nil, errors.New(&fs.PathError{Op: "createtemp", Path: pattern, Err: stderrors.New("pattern contains path separator")})
+	}
+
+	if mkdirFS, ok := dst.(MkdirFS); ok {
+		if err = mkdirFS.MkdirAll(dir, os.ModePerm); err != nil {
+			return nil, "", nil, errors.New(err)
+		}
+	}
+
+	prefix, suffix := pattern, ""
+	if i := strings.LastIndex(pattern, "*"); i != -1 {
+		prefix, suffix = pattern[:i], pattern[i+1:]
+	}
+	filePath = path.Join(dir, fmt.Sprintf("%s%s%s", prefix, uuid.NewString(), suffix))
+
+	file, err = dst.OpenFile(filePath, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, "", nil, errors.New(err)
+	}
+
+	cleanup = func() error {
+		closeErr := file.Close()
+		if closeErr != nil && !stderrors.Is(closeErr, fs.ErrClosed) {
+			return errors.New(closeErr)
+		}
+		if err := dst.Remove(filePath); err != nil && !stderrors.Is(err, fs.ErrNotExist) {
+			return errors.New(err)
+		}
+		return nil
+	}
+	return file, filePath, cleanup, nil
+}
+
+// An AtomicWriteFS is a file system supporting the operations required by `WriteFileAtomic()`.
+type AtomicWriteFS interface {
+	WritableFS
+	RemoveFS
+	RenameFS
+}
+
+// WriteFileAtomic writes the given data to the named file, creating it with the given
+// permissions if necessary, in a way that readers never see a partially written file.
+// The data is first written to a temporary file in the same directory, which is then
+// renamed to its final name, replacing the existing file if any. The parent directory is
+// created if it doesn't exist and if the file system implements `MkdirFS`.
+//
+// The atomicity relies on the `Rename()` operation of the file system: it is atomic
+// on POSIX systems as long as the temporary file and the destination are on the same
+// file system, which is always the case as they are in the same directory.
+// If an error occurs, the temporary file is removed and the existing file is left untouched.
+func WriteFileAtomic(dst AtomicWriteFS, name string, data []byte, perm fs.FileMode) (err error) {
+	dir := path.Dir(name)
+	if mkdirFS, ok := dst.(MkdirFS); ok {
+		if err = mkdirFS.MkdirAll(dir, os.ModePerm); err != nil {
+			return errors.New(err)
+		}
+	}
+
+	tmpPath := path.Join(dir, "."+path.Base(name)+".tmp-"+uuid.NewString())
+	defer func() {
+		if err != nil {
+			if removeErr := dst.Remove(tmpPath); removeErr != nil && !stderrors.Is(removeErr, fs.ErrNotExist) {
+				err = errors.New([]error{err, removeErr})
+			}
+		}
+	}()
+
+	var f io.ReadWriteCloser
+	f, err = dst.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return errors.New(err)
+	}
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.New(err)
+	}
+
+	return errors.New(dst.Rename(tmpPath, name))
+}
@@ -0,0 +1,47 @@
+package fsutil
+
+import (
+	"archive/zip"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"goyave.dev/goyave/v5/util/errors"
+)
+
+// ErrZipBomb returned by `InspectZip()` when a zip archive exceeds the given limits.
+var ErrZipBomb = stderrors.New("fsutil: zip archive exceeds the decompression limits")
+
+// InspectZip reads the central directory of the zip archive of the given size and checks
+// it is not a decompression bomb, without extracting it:
+//   - the sum of the uncompressed sizes of all the entries must not exceed "maxTotal" bytes
+//   - the compression ratio (uncompressed size / compressed size) of each entry must not exceed "maxRatio"
+//
+// If one of the limits is exceeded, the returned error wraps `ErrZipBomb`. Other errors are
+// returned if the archive cannot be read.
+//
+// The checked sizes are the ones declared in the archive, which can be forged. This function
+// rejects most archives that are bombs by design, but the extraction itself should still be
+// bounded (using `io.LimitReader` for example).
+func InspectZip(r io.ReaderAt, size int64, maxTotal int64, maxRatio float64) error {
+	reader, err := zip.NewReader(r, size)
+	if err != nil {
+		return errors.New(err)
+	}
+
+	var total uint64
+	for _, f := range reader.File {
+		uncompressed := f.UncompressedSize64
+		total += uncompressed
+		if total < uncompressed || total > uint64(max(maxTotal, 0)) {
+			return errors.New(fmt.Errorf("%w: total uncompressed size exceeds %d bytes", ErrZipBomb, maxTotal))
+		}
+		if uncompressed == 0 {
+			continue
+		}
+		if f.CompressedSize64 == 0 || float64(uncompressed)/float64(f.CompressedSize64) > maxRatio {
+			return errors.New(fmt.Errorf("%w: compression ratio of %q exceeds %g", ErrZipBomb, f.Name, maxRatio))
+		}
+	}
+	return nil
+}
@@ -77,3 +77,26 @@ func ParseMultiValuesHeader(header string) []HeaderValue {
 
 	return values
 }
+
+// MediaType returns the lowercase media type of the given "Content-Type" header value,
+// without its parameters. For example, "Application/JSON; charset=utf-8" returns "application/json".
+func MediaType(contentType string) string {
+	if i := strings.IndexByte(contentType, ';'); i != -1 {
+		contentType = contentType[:i]
+	}
+	return strings.ToLower(strings.TrimSpace(contentType))
+}
+
+// IsJSON returns true if the given "Content-Type" header value indicates a JSON body.
+// All media types starting with "application/json" are considered JSON, such as
+// "application/json-patch+json" or "application/json-seq".
+func IsJSON(contentType string) bool {
+	return strings.HasPrefix(MediaType(contentType), "application/json")
+}
+
+// IsXML returns true if the given "Content-Type" header value indicates an XML body
+// ("application/xml" or "text/xml").
+func IsXML(contentType string) bool {
+	mediaType := MediaType(contentType)
+	return mediaType == "application/xml" || mediaType == "text/xml"
+}
@@ -53,3 +53,30 @@ func TestParseMultiValuesHeader(t *testing.T) {
 	result = ParseMultiValuesHeader("   ")
 	assert.Equal(t, expected, result)
 }
+
+func TestMediaType(t *testing.T) {
+	cases := []struct {
+		contentType string
+		want        string
+		isJSON      bool
+		isXML       bool
+	}{
+		{contentType: "", want: ""},
+		{contentType: "application/json", want: "application/json", isJSON: true},
+		{contentType: " Application/JSON ; charset=utf-8", want: "application/json", isJSON: true},
+		{contentType: "application/json-patch+json", want: "application/json-patch+json", isJSON: true},
+		{contentType: "application/json-seq", want: "application/json-seq", isJSON: true},
+		{contentType: "application/xml", want: "application/xml", isXML: true},
+		{contentType: "text/xml; charset=utf-8", want: "text/xml", isXML: true},
+		{contentType: "application/x-www-form-urlencoded", want: "application/x-www-form-urlencoded"},
+		{contentType: "multipart/form-data; boundary=abc", want: "multipart/form-data"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.contentType, func(t *testing.T) {
+			assert.Equal(t, c.want, MediaType(c.contentType))
+			assert.Equal(t, c.isJSON, IsJSON(c.contentType))
+			assert.Equal(t, c.isXML, IsXML(c.contentType))
+		})
+	}
+}
@@ -115,6 +115,11 @@ func (p *Path) Walk(currentElement any, f func(*Context)) {
 
 func (p *Path) walk(currentElement any, parent any, index int, trackPath *Path, lastPathElement *Path, f func(*Context)) bool {
 	element := currentElement
+	if p.Name == wildcard {
+		if list := reflect.ValueOf(currentElement); list.Kind() == reflect.Slice {
+			return p.walkWildcardArray(currentElement, parent, index, trackPath, lastPathElement, f)
+		}
+	}
 	if p.Name != nil {
 		ce, ok := currentElement.(map[string]any)
 		notFoundType := ParentNotFound
@@ -219,6 +224,27 @@ func (p *Path) walkArray(element any, parent any, index int, trackPath *Path, la
 	return true
 }
 
+// walkWildcardArray explores all the elements of the given slice matched by a wildcard.
+// The wildcard is treated as if the previous step was an array: the tracked path
+// of "array.*.field" will be "array[0].field", "array[1].field", etc.
+func (p *Path) walkWildcardArray(element any, parent any, index int, trackPath *Path, lastPathElement *Path, f func(*Context)) bool {
+	arrayStep := trackPath
+	for arrayStep.Next != nil && arrayStep.Next != lastPathElement {
+		arrayStep = arrayStep.Next
+	}
+	if arrayStep.Next == nil {
+		// The wildcard is the first step
+		arrayStep.Name = nil
+	}
+	arrayStep.Type = PathTypeArray
+	arrayStep.Next = nil
+
+	// The elements are nameless steps, like the elements of "array[]"
+	next := &Path{Type: p.Type, Index: p.Index, Next: p.Next}
+	array := &Path{Type: PathTypeArray, Next: next}
+	return array.walkArray(element, parent, index, trackPath, arrayStep, f)
+}
+
 func (p *Path) outOfBounds(length int) bool {
 	return *p.Index >= length || *p.Index < 0
 }
@@ -266,6 +292,19 @@ func (p *Path) First(currentElement any) *Context {
 	return result
 }
 
+// Lookup returns the value of the first final element matched by the Path.
+// The second returned value is `false` if the element could not be found.
+//
+// This is a shorthand for `First()` for when the exact path to the matched element
+// is not needed. The same remark about wildcards applies.
+func (p *Path) Lookup(currentElement any) (any, bool) {
+	result := p.First(currentElement)
+	if result == nil || result.Found != Found {
+		return nil, false
+	}
+	return result.Value, true
+}
+
 // HasArray returns true if a least one step in the path involves an array.
 func (p *Path) HasArray() bool {
 	step := p
@@ -413,9 +452,12 @@ func (p *Path) setAllMissingIndexes() {
 
 // Parse transform given path string representation into usable Path.
 //
-// The wildcard `*` can be used to match all keys of an object. It is only effective
-// if it is an entire path segment. For example, the `*` in `field*name` won't be
-// considered a wildcard and only the literal field will match.
+// The wildcard `*` can be used to match all keys of an object or all elements of an array.
+// It is only effective if it is an entire path segment. For example, the `*` in `field*name`
+// won't be considered a wildcard and only the literal field will match.
+// When it matches array elements, the exact paths given to `Walk` callbacks use the
+// array notation (e.g. "items.*.price" yields "items[0].price") and empty arrays are
+// handled the same way as with "items[].price".
 //
 // Special characters defined in the `Escape` map (by default `*`, `[`, `]`, `.` and `\`)
 // can be escaped using a backslack `\`.
@@ -616,11 +616,9 @@ func TestPathWalk(t *testing.T) {
 	matches = testWalk(t, data, `object.\*`)
 	assert.ElementsMatch(t, expected, matches)
 
-	// object.* but object is not an object
+	// object.* but object is not an object nor an array
 	data = map[string]any{
-		"object": []string{
-			"a", "b", "c",
-		},
+		"object": "abc",
 	}
 	expected = []*Context{
 		{
@@ -1128,6 +1126,71 @@ func TestPathWalkWildcardEmptyObject(t *testing.T) {
 	assert.Equal(t, expected, matches)
 }
 
+func TestPathWalkWildcardArray(t *testing.T) {
+	type match struct {
+		Value  any
+		Parent any
+		Path   string
+		Name   string
+		Index  int
+		Found  FoundType
+	}
+	walkMatches := func(data any, p string) []match {
+		return lo.Map(testWalk(t, data, p), func(c *Context, _ int) match {
+			return match{Value: c.Value, Parent: c.Parent, Path: c.Path.String(), Name: c.Name, Index: c.Index, Found: c.Found}
+		})
+	}
+
+	data := map[string]any{
+		"items": []any{
+			map[string]any{"price": 1},
+			map[string]any{"price": 2},
+		},
+		"strings": []string{"a", "b"},
+		"empty":   []any{},
+	}
+
+	cases := []struct {
+		path string
+		want []match
+	}{
+		{
+			path: "items.*.price",
+			want: []match{
+				{Value: 1, Parent: data["items"].([]any)[0], Path: "items[0].price", Name: "price", Index: -1, Found: Found},
+				{Value: 2, Parent: data["items"].([]any)[1], Path: "items[1].price", Name: "price", Index: -1, Found: Found},
+			},
+		},
+		{
+			path: "strings.*",
+			want: []match{
+				{Value: "a", Parent: data["strings"], Path: "strings[0]", Index: 0, Found: Found},
+				{Value: "b", Parent: data["strings"], Path: "strings[1]", Index: 1, Found: Found},
+			},
+		},
+		{
+			path: "empty.*",
+			want: []match{
+				{Value: nil, Parent: data["empty"], Path: "empty[]", Index: -1, Found: ElementNotFound},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.path, func(t *testing.T) {
+			assert.Equal(t, c.want, walkMatches(data, c.path))
+		})
+	}
+
+	t.Run("root", func(t *testing.T) {
+		data := []any{map[string]any{"price": 1}}
+		want := []match{
+			{Value: 1, Parent: data[0], Path: "[0].price", Name: "price", Index: -1, Found: Found},
+		}
+		assert.Equal(t, want, walkMatches(data, "*.price"))
+	})
+}
+
 func TestPathWalkEmptyArray(t *testing.T) {
 	var data any = map[string]any{
 		"array":  []string{},
@@ -1677,6 +1740,50 @@ func TestPathFirst(t *testing.T) {
 	assert.Equal(t, expected, ctx)
 }
 
+func TestPathLookup(t *testing.T) {
+	data := map[string]any{
+		"object": map[string]any{
+			"field": "value",
+			"array": []any{
+				map[string]any{"field": 1},
+				map[string]any{"field": 2},
+			},
+		},
+		"empty": []any{},
+	}
+
+	cases := []struct {
+		want      any
+		path      string
+		wantFound bool
+	}{
+		{path: "object.field", want: "value", wantFound: true},
+		{path: "object.array[].field", want: 1, wantFound: true},
+		{path: "object.array[]", want: map[string]any{"field": 1}, wantFound: true},
+		{path: "object.missing", want: nil, wantFound: false},
+		{path: "missing.field", want: nil, wantFound: false},
+		{path: "empty[]", want: nil, wantFound: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.path, func(t *testing.T) {
+			path, err := Parse(c.path)
+			require.NoError(t, err)
+			value, found := path.Lookup(data)
+			assert.Equal(t, c.want, value)
+			assert.Equal(t, c.wantFound, found)
+		})
+	}
+
+	t.Run("malformed", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			path, err := Parse("invalid[path.")
+			require.Error(t, err)
+			assert.Nil(t, path)
+		})
+	})
+}
+
 func TestPathSetAllMissingIndexes(t *testing.T) {
 	path := &Path{
 		Name: strPtr("array"),
@@ -73,11 +73,21 @@ func (v *AfterFieldValidator) Name() string { return "after" }
 // AfterField the field under validation must be a date (`time.Time`) before the date field identified
 // by the given path.
 func AfterField(path string) *AfterFieldValidator {
+	v, err := AfterFieldE(path)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// AfterFieldE is the same as `AfterField()` but returns an error instead of panicking
+// if the given path cannot be parsed.
+func AfterFieldE(path string) (*AfterFieldValidator, error) {
 	p, err := walk.Parse(path)
 	if err != nil {
-		panic(errors.NewSkip(fmt.Errorf("validation.AfterField: path parse error: %w", err), 3))
+		return nil, fmt.Errorf("validation.AfterField: path parse error: %w", err)
 	}
-	return &AfterFieldValidator{DateFieldComparisonValidator: DateFieldComparisonValidator{Path: p}}
+	return &AfterFieldValidator{DateFieldComparisonValidator: DateFieldComparisonValidator{Path: p}}, nil
 }
 
 //------------------------------
@@ -101,9 +111,19 @@ func (v *AfterEqualFieldValidator) Name() string { return "after_equal" }
 // AfterEqualField the field under validation must be a date (`time.Time`) after or equal to the date field identified
 // by the given path.
 func AfterEqualField(path string) *AfterEqualFieldValidator {
+	v, err := AfterEqualFieldE(path)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// AfterEqualFieldE is the same as `AfterEqualField()` but returns an error instead of panicking
+// if the given path cannot be parsed.
+func AfterEqualFieldE(path string) (*AfterEqualFieldValidator, error) {
 	p, err := walk.Parse(path)
 	if err != nil {
-		panic(errors.NewSkip(fmt.Errorf("validation.AfterEqualField: path parse error: %w", err), 3))
+		return nil, fmt.Errorf("validation.AfterEqualField: path parse error: %w", err)
 	}
-	return &AfterEqualFieldValidator{DateFieldComparisonValidator: DateFieldComparisonValidator{Path: p}}
+	return &AfterEqualFieldValidator{DateFieldComparisonValidator: DateFieldComparisonValidator{Path: p}}, nil
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"goyave.dev/goyave/v5/lang"
 )
 
@@ -104,6 +105,13 @@ func TestAfterFieldValidator(t *testing.T) {
 		assert.Panics(t, func() {
 			AfterField("invalid[path.")
 		})
+
+		v2, err := AfterFieldE(path)
+		require.NoError(t, err)
+		assert.Equal(t, path, v2.Path.String())
+		v2, err = AfterFieldE("invalid[path.")
+		require.Error(t, err)
+		assert.Nil(t, v2)
 	})
 
 	ref1 := lo.Must(time.Parse(time.RFC3339, "2023-03-15T10:07:42Z"))
@@ -160,6 +168,13 @@ func TestAfterEqualFieldValidator(t *testing.T) {
 		assert.Panics(t, func() {
 			AfterEqualField("invalid[path.")
 		})
+
+		v2, err := AfterEqualFieldE(path)
+		require.NoError(t, err)
+		assert.Equal(t, path, v2.Path.String())
+		v2, err = AfterEqualFieldE("invalid[path.")
+		require.Error(t, err)
+		assert.Nil(t, v2)
 	})
 
 	ref1 := lo.Must(time.Parse(time.RFC3339, "2023-03-15T10:07:42Z"))
@@ -214,3 +229,47 @@ func makeAfterFieldData(ref ...any) map[string]any {
 		},
 	}
 }
+
+func TestAfterFieldValidatorWildcard(t *testing.T) {
+	ref1 := lo.Must(time.Parse(time.RFC3339, "2023-03-15T10:07:42Z"))
+	ref2 := lo.Must(time.Parse(time.RFC3339, "2023-03-16T10:07:42Z"))
+	value := lo.Must(time.Parse(time.RFC3339, "2023-03-16T00:00:00Z"))
+
+	cases := []struct {
+		data map[string]any
+		desc string
+		want bool
+	}{
+		{desc: "all_match", data: map[string]any{"items": []any{map[string]any{"date": ref1}, map[string]any{"date": ref1}}}, want: true},
+		{desc: "one_fails", data: map[string]any{"items": []any{map[string]any{"date": ref1}, map[string]any{"date": ref2}}}, want: false},
+		{desc: "object", data: map[string]any{"items": map[string]any{"a": map[string]any{"date": ref1}, "b": map[string]any{"date": ref2}}}, want: false},
+		{desc: "zero_matches", data: map[string]any{"items": []any{}}, want: true},
+		{desc: "zero_matches_object", data: map[string]any{"items": map[string]any{}}, want: true},
+		{desc: "missing_date", data: map[string]any{"items": []any{map[string]any{}}}, want: false},
+		{desc: "missing", data: map[string]any{}, want: false},
+		{desc: "not_a_collection", data: map[string]any{"items": "string"}, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			v := AfterField("items.*.date")
+			assert.Equal(t, c.want, v.Validate(&Context{
+				Value: value,
+				Data:  c.data,
+			}))
+		})
+	}
+
+	t.Run("zero_matches_elements", func(t *testing.T) {
+		// Like "dates[]", comparing to the elements of an empty array passes
+		v := AfterField("dates.*")
+		assert.True(t, v.Validate(&Context{
+			Value: value,
+			Data:  map[string]any{"dates": []any{}},
+		}))
+		assert.False(t, v.Validate(&Context{
+			Value: value,
+			Data:  map[string]any{},
+		}))
+	})
+}
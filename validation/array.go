@@ -28,6 +28,11 @@ func (v *ArrayValidator) IsType() bool { return true }
 // On successful validation and if possible, converts the array to its correct type
 // based on its elements' type. If all elements have the same type, the array is converted to
 // a slice of this type.
+//
+// This validator also marks the field as an array for the validation engine (see `Field.IsArray()`).
+// If `Options.ConvertSingleValueArrays` is enabled, a single value is converted to a one-element
+// slice before any validator is executed, so the elements can be validated using the
+// "field[]" path.
 func Array() *ArrayValidator {
 	return &ArrayValidator{}
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"goyave.dev/goyave/v5/lang"
 	"goyave.dev/goyave/v5/util/fsutil"
 )
 
@@ -65,3 +66,38 @@ func TestArrayValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestArrayValidatorSingleValueConversion(t *testing.T) {
+	cases := []struct {
+		want      any
+		wantError bool
+		desc      string
+		convert   bool
+	}{
+		{desc: "convert", convert: true, want: []int{1}},
+		{desc: "no_convert", convert: false, want: "1", wantError: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			// Simulates a url-encoded "field=1" request body
+			data := map[string]any{"field": "1"}
+			validationErrors, errs := Validate(&Options{
+				Data:                     data,
+				ConvertSingleValueArrays: c.convert,
+				Language:                 lang.Default,
+				Rules: RuleSet{
+					{Path: "field", Rules: List{Required(), Array()}},
+					{Path: "field[]", Rules: List{Int()}},
+				},
+			})
+			assert.Empty(t, errs)
+			if c.wantError {
+				assert.NotNil(t, validationErrors)
+			} else {
+				assert.Nil(t, validationErrors)
+			}
+			assert.Equal(t, c.want, data["field"])
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+	"goyave.dev/goyave/v5/util/errors"
+	"goyave.dev/goyave/v5/util/fsutil"
+)
+
+// Base64ImageValidator validates the field under validation must be a base64-encoded
+// string (optionally in the form of a data URI such as "data:image/png;base64,...")
+// whose decoded content doesn't exceed `MaxBytes` and has one of the allowed MIME types.
+// The MIME type is detected from the decoded content, the type written in the data URI is ignored.
+//
+// If `Convert` is true, the field value is replaced with the decoded `[]byte`.
+type Base64ImageValidator struct {
+	BaseValidator
+	MIMETypes []string
+	MaxBytes  int
+	Convert   bool
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *Base64ImageValidator) Validate(ctx *Context) bool {
+	str, ok := ctx.Value.(string)
+	if !ok {
+		return false
+	}
+
+	if strings.HasPrefix(str, "data:") {
+		i := strings.IndexByte(str, ',')
+		if i == -1 || !strings.HasSuffix(str[:i], ";base64") {
+			return false
+		}
+		str = str[i+1:]
+	}
+
+	// Check the size before decoding to avoid allocating large buffers.
+	if base64.StdEncoding.DecodedLen(len(str)) > v.MaxBytes+2 {
+		return false
+	}
+	data, err := base64.StdEncoding.DecodeString(str)
+	if err != nil || len(data) == 0 || len(data) > v.MaxBytes {
+		return false
+	}
+
+	mime, err := fsutil.DetectContentType(bytes.NewReader(data), "")
+	if err != nil {
+		return false
+	}
+	if i := strings.Index(mime, ";"); i != -1 { // Ignore MIME settings (example: "text/plain; charset=utf-8")
+		mime = mime[:i]
+	}
+	if !lo.Contains(v.MIMETypes, mime) {
+		return false
+	}
+
+	if v.Convert {
+		ctx.Value = data
+	}
+	return true
+}
+
+// Name returns the string name of the validator.
+func (v *Base64ImageValidator) Name() string { return "base64_image" }
+
+// MessagePlaceholders returns the ":values" and ":max" placeholders.
+func (v *Base64ImageValidator) MessagePlaceholders(_ *Context) []string {
+	return []string{
+		":values", strings.Join(v.MIMETypes, ", "),
+		":max", strconv.Itoa(v.MaxBytes),
+	}
+}
+
+// Base64Image the field under validation must be a base64-encoded string (optionally in the
+// form of a data URI such as "data:image/png;base64,...") whose decoded content doesn't exceed
+// "maxBytes" and has one of the given MIME types. If no MIME type is given, `ImageMIMETypes` are used.
+// The MIME type is detected from the decoded content, the type written in the data URI is ignored.
+//
+// Set the `Convert` field of the returned validator to true to replace the field value with the decoded `[]byte`.
+//
+// Panics if "maxBytes" is lower than 1.
+func Base64Image(maxBytes int, mimeTypes ...string) *Base64ImageValidator {
+	if maxBytes < 1 {
+		panic(errors.NewSkip(fmt.Errorf("validation.Base64Image: maxBytes must be greater than 0, %d given", maxBytes), 3))
+	}
+	if len(mimeTypes) == 0 {
+		mimeTypes = ImageMIMETypes
+	}
+	return &Base64ImageValidator{MaxBytes: maxBytes, MIMETypes: mimeTypes}
+}
@@ -0,0 +1,84 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBase64PNG(t *testing.T) ([]byte, string) {
+	buf := &bytes.Buffer{}
+	require.NoError(t, png.Encode(buf, image.NewRGBA(image.Rect(0, 0, 2, 2))))
+	return buf.Bytes(), base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+func TestBase64ImageValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := Base64Image(1024)
+		assert.NotNil(t, v)
+		assert.Equal(t, "base64_image", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Equal(t, ImageMIMETypes, v.MIMETypes)
+		assert.Equal(t, 1024, v.MaxBytes)
+		assert.False(t, v.Convert)
+		assert.Equal(t, []string{":values", "image/png, image/jpeg", ":max", "2048"}, Base64Image(2048, "image/png", "image/jpeg").MessagePlaceholders(&Context{}))
+
+		assert.Panics(t, func() {
+			Base64Image(0)
+		})
+	})
+
+	pngData, pngBase64 := makeBase64PNG(t)
+	pdfBase64 := base64.StdEncoding.EncodeToString([]byte("%PDF-1.4\n%âãÏÓ\n1 0 obj\n<<>>\nendobj\n"))
+
+	cases := []struct {
+		value    any
+		desc     string
+		maxBytes int
+		want     bool
+	}{
+		{desc: "data URI png", value: "data:image/png;base64," + pngBase64, maxBytes: 1024, want: true},
+		{desc: "raw png", value: pngBase64, maxBytes: 1024, want: true},
+		{desc: "data URI wrong declared type", value: "data:image/jpeg;base64," + pngBase64, maxBytes: 1024, want: true},
+		{desc: "exact size", value: pngBase64, maxBytes: len(pngData), want: true},
+		{desc: "oversized", value: pngBase64, maxBytes: len(pngData) - 1, want: false},
+		{desc: "pdf", value: "data:application/pdf;base64," + pdfBase64, maxBytes: 1024, want: false},
+		{desc: "invalid base64", value: "data:image/png;base64,not base64!", maxBytes: 1024, want: false},
+		{desc: "data URI not base64", value: "data:image/png," + pngBase64, maxBytes: 1024, want: false},
+		{desc: "data URI without comma", value: "data:image/png;base64", maxBytes: 1024, want: false},
+		{desc: "empty", value: "", maxBytes: 1024, want: false},
+		{desc: "not a string", value: 123, maxBytes: 1024, want: false},
+		{desc: "bytes", value: pngData, maxBytes: 1024, want: false},
+		{desc: "nil", value: nil, maxBytes: 1024, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%s_%t", c.desc, c.want), func(t *testing.T) {
+			v := Base64Image(c.maxBytes)
+			ctx := &Context{Value: c.value}
+			assert.Equal(t, c.want, v.Validate(ctx))
+			assert.Equal(t, c.value, ctx.Value)
+		})
+	}
+
+	t.Run("custom_types", func(t *testing.T) {
+		v := Base64Image(1024, "application/pdf")
+		assert.True(t, v.Validate(&Context{Value: pdfBase64}))
+		assert.False(t, v.Validate(&Context{Value: pngBase64}))
+	})
+
+	t.Run("convert", func(t *testing.T) {
+		v := Base64Image(1024)
+		v.Convert = true
+		ctx := &Context{Value: "data:image/png;base64," + pngBase64}
+		assert.True(t, v.Validate(ctx))
+		assert.Equal(t, pngData, ctx.Value)
+	})
+}
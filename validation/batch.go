@@ -0,0 +1,60 @@
+package validation
+
+import (
+	"maps"
+	"runtime"
+	"sync"
+)
+
+// ValidateEach validates each one of the given records separately, which is useful when
+// importing a batch of rows (from a CSV or a JSON array for example).
+//
+// The records are validated concurrently by a pool of at most `runtime.GOMAXPROCS(0)` workers.
+// Because validators are not safe for concurrent use, the rules are not given directly:
+// the "rules" function is called for every record to create a new instance of the rule set.
+//
+// The given options are used as a template for each record's validation. `Options.Data`
+// and `Options.Rules` are ignored. Each record is given a shallow copy of `Options.Extra`,
+// so validators can add or replace keys without affecting the other records. The values
+// themselves are still shared.
+//
+// The returned slices are in the same order as the input and contain, for each record:
+//   - its validation errors, or `nil` if the record didn't fail any validator
+//   - the errors raised by its validators (see `Context.AddError()`), or `nil`
+//
+// A record is valid only if both are `nil`: a record whose validators raised errors
+// hasn't been fully validated. Like `Validate()`, the records may be modified thanks to type rules.
+func ValidateEach(records []map[string]any, rules func() Ruler, options Options) ([]*Errors, [][]error) {
+	results := make([]*Errors, len(records))
+	errs := make([][]error, len(records))
+
+	indexes := make(chan int)
+	workers := min(runtime.GOMAXPROCS(0), len(records))
+	wg := sync.WaitGroup{}
+	wg.Add(workers)
+	for range workers {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				opt := options
+				opt.Data = records[i]
+				opt.Rules = rules()
+				if options.Extra != nil {
+					opt.Extra = maps.Clone(options.Extra)
+				}
+				results[i], errs[i] = Validate(&opt)
+				if data, ok := opt.Data.(map[string]any); ok {
+					records[i] = data
+				}
+			}
+		}()
+	}
+
+	for i := range records {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results, errs
+}
@@ -0,0 +1,90 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goyave.dev/goyave/v5/lang"
+)
+
+func TestValidateEach(t *testing.T) {
+	rules := func() Ruler {
+		return RuleSet{
+			{Path: "name", Rules: List{Required(), String()}},
+			{Path: "age", Rules: List{Required(), Int(), Min(18)}},
+		}
+	}
+
+	t.Run("mixed", func(t *testing.T) {
+		records := make([]map[string]any, 0, 100)
+		for i := range 100 {
+			age := fmt.Sprintf("%d", i)
+			records = append(records, map[string]any{"name": fmt.Sprintf("name %d", i), "age": age})
+		}
+
+		results, errs := ValidateEach(records, rules, Options{Language: lang.New().GetDefault()})
+		require.Len(t, errs, 100)
+		require.Len(t, results, 100)
+		for i, result := range results {
+			assert.Nil(t, errs[i], i)
+			if i < 18 {
+				if assert.NotNil(t, result, i) {
+					assert.Equal(t, []string{"The age must be at least 18."}, result.Fields["age"].Errors, i)
+				}
+			} else {
+				assert.Nil(t, result, i)
+			}
+			assert.Equal(t, i, records[i]["age"]) // Converted
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		results, errs := ValidateEach([]map[string]any{}, rules, Options{})
+		assert.Empty(t, errs)
+		assert.Empty(t, results)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		records := []map[string]any{{"field": "a"}, {"field": "b"}, {"field": "c"}}
+		results, errs := ValidateEach(records, func() Ruler {
+			return RuleSet{
+				{Path: "field", Rules: List{&testValidator{validateFunc: func(_ component, ctx *Context) bool {
+					if ctx.Value == "b" {
+						ctx.AddError(fmt.Errorf("test error"))
+					}
+					return true
+				}}}},
+			}
+		}, Options{})
+		assert.Equal(t, []*Errors{nil, nil, nil}, results)
+		require.Len(t, errs, 3)
+		assert.Nil(t, errs[0])
+		if assert.Len(t, errs[1], 1) {
+			assert.ErrorContains(t, errs[1][0], "test error")
+		}
+		assert.Nil(t, errs[2])
+	})
+
+	t.Run("extra", func(t *testing.T) {
+		extra := map[any]any{extraKey{}: "template"}
+		records := []map[string]any{{"field": "a"}, {"field": "b"}}
+		seen := make([]any, len(records))
+		results, errs := ValidateEach(records, func() Ruler {
+			return RuleSet{
+				{Path: "field", Rules: List{&testValidator{validateFunc: func(_ component, ctx *Context) bool {
+					i := lo.Ternary(ctx.Value == "a", 0, 1)
+					seen[i] = ctx.Extra[extraKey{}]
+					ctx.Extra[extraKey{}] = ctx.Value
+					return true
+				}}}},
+			}
+		}, Options{Extra: extra})
+		assert.Equal(t, []*Errors{nil, nil}, results)
+		assert.Equal(t, [][]error{nil, nil}, errs)
+		assert.Equal(t, []any{"template", "template"}, seen)
+		assert.Equal(t, map[any]any{extraKey{}: "template"}, extra)
+	})
+}
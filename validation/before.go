@@ -73,11 +73,21 @@ func (v *BeforeFieldValidator) Name() string { return "before" }
 // BeforeField the field under validation must be a date (`time.Time`) before the date field identified
 // by the given path.
 func BeforeField(path string) *BeforeFieldValidator {
+	v, err := BeforeFieldE(path)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// BeforeFieldE is the same as `BeforeField()` but returns an error instead of panicking
+// if the given path cannot be parsed.
+func BeforeFieldE(path string) (*BeforeFieldValidator, error) {
 	p, err := walk.Parse(path)
 	if err != nil {
-		panic(errors.NewSkip(fmt.Errorf("validation.BeforeField: path parse error: %w", err), 3))
+		return nil, fmt.Errorf("validation.BeforeField: path parse error: %w", err)
 	}
-	return &BeforeFieldValidator{DateFieldComparisonValidator: DateFieldComparisonValidator{Path: p}}
+	return &BeforeFieldValidator{DateFieldComparisonValidator: DateFieldComparisonValidator{Path: p}}, nil
 }
 
 //------------------------------
@@ -101,9 +111,19 @@ func (v *BeforeEqualFieldValidator) Name() string { return "before_equal" }
 // BeforeEqualField the field under validation must be a date (`time.Time`) before or equal to the date field identified
 // by the given path.
 func BeforeEqualField(path string) *BeforeEqualFieldValidator {
+	v, err := BeforeEqualFieldE(path)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// BeforeEqualFieldE is the same as `BeforeEqualField()` but returns an error instead of panicking
+// if the given path cannot be parsed.
+func BeforeEqualFieldE(path string) (*BeforeEqualFieldValidator, error) {
 	p, err := walk.Parse(path)
 	if err != nil {
-		panic(errors.NewSkip(fmt.Errorf("validation.BeforeEqualField: path parse error: %w", err), 3))
+		return nil, fmt.Errorf("validation.BeforeEqualField: path parse error: %w", err)
 	}
-	return &BeforeEqualFieldValidator{DateFieldComparisonValidator: DateFieldComparisonValidator{Path: p}}
+	return &BeforeEqualFieldValidator{DateFieldComparisonValidator: DateFieldComparisonValidator{Path: p}}, nil
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"goyave.dev/goyave/v5/lang"
 )
 
@@ -104,6 +105,13 @@ func TestBeforeFieldValidator(t *testing.T) {
 		assert.Panics(t, func() {
 			BeforeField("invalid[path.")
 		})
+
+		v2, err := BeforeFieldE(path)
+		require.NoError(t, err)
+		assert.Equal(t, path, v2.Path.String())
+		v2, err = BeforeFieldE("invalid[path.")
+		require.Error(t, err)
+		assert.Nil(t, v2)
 	})
 
 	ref1 := lo.Must(time.Parse(time.RFC3339, "2023-03-15T10:07:42Z"))
@@ -160,6 +168,13 @@ func TestBeforeEqualFieldValidator(t *testing.T) {
 		assert.Panics(t, func() {
 			BeforeEqualField("invalid[path.")
 		})
+
+		v2, err := BeforeEqualFieldE(path)
+		require.NoError(t, err)
+		assert.Equal(t, path, v2.Path.String())
+		v2, err = BeforeEqualFieldE("invalid[path.")
+		require.Error(t, err)
+		assert.Nil(t, v2)
 	})
 
 	ref1 := lo.Must(time.Parse(time.RFC3339, "2023-03-15T10:07:42Z"))
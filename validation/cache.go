@@ -0,0 +1,259 @@
+package validation
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"goyave.dev/goyave/v5/util/errors"
+)
+
+type cacheKey [sha256.Size]byte
+
+type cacheEntry struct {
+	data             any
+	validationErrors *Errors
+	warnings         *Errors
+	key              cacheKey
+}
+
+// ResultCache memoizes validation results so validating the same data with the
+// same rule set multiple times (for example the identical rows of a batch import)
+// only runs the validators once. Set it in `Options.Cache` to enable it.
+//
+// Results are identified by the rules, the data and the options affecting the result
+// (`Language`, `Request`, `Method`, `Now`, `ConvertSingleValueArrays`, etc). The rules are
+// compared by value: the path of each field and the name and parameters (exported fields) of
+// each validator. Therefore, a new instance of the same rule set can be created for each
+// validation, as recommended. Rule sets containing validators that cannot be compared, such as
+// `OnlyIf()` or `RequiredIf()` (they contain functions), are never cached. Only use a cache with rule
+// sets whose result depends solely on the validated data: validators using the database,
+// the current time or `Options.Extra` may produce different results for the same data.
+//
+// The returned `*Errors` are shared between hits and must not be modified. The validated
+// data assigned to `Options.Data` is a copy. A cache is meant to be short-lived (the
+// scope of a request for example). It is safe for concurrent use and holds at most
+// the given number of entries, evicting the least recently used ones first.
+type ResultCache struct {
+	entries    map[cacheKey]*list.Element
+	order      *list.List
+	maxEntries int
+	mu         sync.Mutex
+}
+
+// NewResultCache creates a new `ResultCache` holding at most "maxEntries" results.
+// Panics if "maxEntries" is lower than 1.
+func NewResultCache(maxEntries int) *ResultCache {
+	if maxEntries < 1 {
+		panic(errors.NewSkip(fmt.Errorf("validation.NewResultCache: maxEntries must be greater than 0, %d given", maxEntries), 3))
+	}
+	return &ResultCache{
+		entries:    make(map[cacheKey]*list.Element, maxEntries),
+		order:      list.New(),
+		maxEntries: maxEntries,
+	}
+}
+
+// Len returns the number of results currently stored in the cache.
+func (c *ResultCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *ResultCache) get(key cacheKey) (*cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*cacheEntry), true
+}
+
+func (c *ResultCache) put(entry *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[entry.key]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[entry.key] = c.order.PushFront(entry)
+	if c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+// maxCacheKeyDepth the maximum nesting depth of the values encoded in a cache key.
+// Deeper (or cyclic) values make the validation not cacheable.
+const maxCacheKeyDepth = 64
+
+var (
+	baseValidatorType = reflect.TypeOf(BaseValidator{})
+	timeType          = reflect.TypeOf(time.Time{})
+)
+
+// resultCacheKey computes the key identifying the validation of the given data
+// with the given rules and options. The second returned value is false if the
+// rules or the data cannot be encoded reliably, in which case the result must not be cached.
+func resultCacheKey(rules Rules, options *Options) (cacheKey, bool) {
+	h := sha256.New()
+	for _, field := range rules {
+		if !writeFieldKey(h, field) {
+			return cacheKey{}, false
+		}
+	}
+	fmt.Fprintf(h, "|%p|%p|%q|%d|%t|%t|%t|%t|%t|%d|%d|",
+		options.Language, options.Request, options.Method, options.Now.UnixNano(),
+		options.ConvertSingleValueArrays, options.SkipTypeDependentOnTypeError,
+		options.StripUnknown, options.RejectUnknown, options.NoMutation,
+		options.MaxErrors, options.Concurrency,
+	)
+	if !writeValueKey(h, reflect.ValueOf(options.Data), false, 0) {
+		return cacheKey{}, false
+	}
+	var key cacheKey
+	h.Sum(key[:0])
+	return key, true
+}
+
+// writeFieldKey writes the fingerprint of the given field: its path and the name and
+// parameters (exported fields) of its validators.
+func writeFieldKey(w io.Writer, field *Field) bool {
+	fmt.Fprintf(w, "%q:", field.Path.String())
+	for _, v := range field.Validators {
+		fmt.Fprintf(w, "%q", v.Name())
+		if !writeValueKey(w, reflect.ValueOf(v), true, 0) {
+			return false
+		}
+		fmt.Fprint(w, ",")
+	}
+	if field.Elements != nil {
+		fmt.Fprint(w, "[")
+		if !writeFieldKey(w, field.Elements) {
+			return false
+		}
+		fmt.Fprint(w, "]")
+	}
+	fmt.Fprint(w, ";")
+	return true
+}
+
+// writeValueKey writes a canonical encoding of the given value: the same values always
+// produce the same encoding, and different values (or values of different types) produce
+// different encodings. Maps are sorted by key. If "exportedOnly" is true, the unexported struct
+// fields and `BaseValidator` are ignored.
+//
+// Returns false if the value cannot be encoded reliably: functions and channels
+// cannot be compared, and values nested deeper than `maxCacheKeyDepth` may be cyclic.
+func writeValueKey(w io.Writer, v reflect.Value, exportedOnly bool, depth int) bool {
+	if depth > maxCacheKeyDepth {
+		return false
+	}
+	if !v.IsValid() {
+		fmt.Fprint(w, "nil")
+		return true
+	}
+	t := v.Type()
+	switch v.Kind() {
+	case reflect.Bool:
+		fmt.Fprintf(w, "%s(%t)", t, v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		fmt.Fprintf(w, "%s(%d)", t, v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		fmt.Fprintf(w, "%s(%d)", t, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		fmt.Fprintf(w, "%s(%s)", t, strconv.FormatFloat(v.Float(), 'g', -1, 64))
+	case reflect.Complex64, reflect.Complex128:
+		fmt.Fprintf(w, "%s(%s)", t, strconv.FormatComplex(v.Complex(), 'g', -1, 128))
+	case reflect.String:
+		fmt.Fprintf(w, "%s(%q)", t, v.String())
+	case reflect.Interface:
+		return writeValueKey(w, v.Elem(), exportedOnly, depth+1)
+	case reflect.Pointer:
+		if v.IsNil() {
+			fmt.Fprintf(w, "%s(nil)", t)
+			return true
+		}
+		if v.CanInterface() {
+			if stringer, ok := v.Interface().(fmt.Stringer); ok {
+				// Paths, regular expressions, etc.
+				fmt.Fprintf(w, "%s(%q)", t, stringer.String())
+				return true
+			}
+		}
+		fmt.Fprint(w, "&")
+		return writeValueKey(w, v.Elem(), exportedOnly, depth+1)
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			fmt.Fprintf(w, "%s(nil)", t)
+			return true
+		}
+		fmt.Fprintf(w, "%s[%d]{", t, v.Len())
+		for i := range v.Len() {
+			if !writeValueKey(w, v.Index(i), exportedOnly, depth+1) {
+				return false
+			}
+			fmt.Fprint(w, ",")
+		}
+		fmt.Fprint(w, "}")
+	case reflect.Map:
+		if v.IsNil() {
+			fmt.Fprintf(w, "%s(nil)", t)
+			return true
+		}
+		entries := make([]string, 0, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			entry := &bytes.Buffer{}
+			if !writeValueKey(entry, iter.Key(), exportedOnly, depth+1) {
+				return false
+			}
+			entry.WriteString(":")
+			if !writeValueKey(entry, iter.Value(), exportedOnly, depth+1) {
+				return false
+			}
+			entries = append(entries, entry.String())
+		}
+		slices.Sort(entries)
+		fmt.Fprintf(w, "%s[%d]{%s}", t, len(entries), strings.Join(entries, ","))
+	case reflect.Struct:
+		if t == timeType && v.CanInterface() {
+			date := v.Interface().(time.Time)
+			fmt.Fprintf(w, "%s(%s)", t, date.Format(time.RFC3339Nano))
+			return true
+		}
+		fmt.Fprintf(w, "%s{", t)
+		for i := range v.NumField() {
+			field := t.Field(i)
+			if exportedOnly && (!field.IsExported() || field.Type == baseValidatorType) {
+				continue
+			}
+			fmt.Fprintf(w, "%s:", field.Name)
+			if !writeValueKey(w, v.Field(i), exportedOnly, depth+1) {
+				return false
+			}
+			fmt.Fprint(w, ",")
+		}
+		fmt.Fprint(w, "}")
+	default:
+		// Functions, channels and unsafe pointers
+		if !v.IsNil() {
+			return false
+		}
+		fmt.Fprintf(w, "%s(nil)", t)
+	}
+	return true
+}
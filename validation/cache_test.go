@@ -0,0 +1,250 @@
+package validation
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goyave.dev/goyave/v5/lang"
+)
+
+type countingValidator struct {
+	BaseValidator
+	calls int
+}
+
+func (v *countingValidator) Validate(ctx *Context) bool {
+	v.calls++
+	s, ok := ctx.Value.(string)
+	return ok && s != "invalid"
+}
+
+func (v *countingValidator) Name() string { return "counting" }
+
+func TestResultCache(t *testing.T) {
+
+	t.Run("NewResultCache", func(t *testing.T) {
+		c := NewResultCache(3)
+		assert.Equal(t, 3, c.maxEntries)
+		assert.Equal(t, 0, c.Len())
+
+		assert.Panics(t, func() {
+			NewResultCache(0)
+		})
+	})
+
+	t.Run("hit", func(t *testing.T) {
+		v := &countingValidator{}
+		rules := RuleSet{
+			{Path: "field", Rules: List{Required(), v}},
+		}
+		cache := NewResultCache(10)
+
+		opts := &Options{Data: map[string]any{"field": "invalid"}, Rules: rules, Cache: cache}
+		errs, err := Validate(opts)
+		require.Empty(t, err)
+		require.NotNil(t, errs)
+
+		opts2 := &Options{Data: map[string]any{"field": "invalid"}, Rules: rules, Cache: cache}
+		errs2, err := Validate(opts2)
+		require.Empty(t, err)
+		assert.Same(t, errs, errs2)
+		assert.Equal(t, 1, v.calls)
+		assert.Equal(t, 1, cache.Len())
+	})
+
+	t.Run("different_data", func(t *testing.T) {
+		v := &countingValidator{}
+		rules := RuleSet{
+			{Path: "field", Rules: List{Required(), v}},
+		}
+		cache := NewResultCache(10)
+
+		errs, err := Validate(&Options{Data: map[string]any{"field": "invalid"}, Rules: rules, Cache: cache})
+		require.Empty(t, err)
+		assert.NotNil(t, errs)
+
+		errs, err = Validate(&Options{Data: map[string]any{"field": "valid"}, Rules: rules, Cache: cache})
+		require.Empty(t, err)
+		assert.Nil(t, errs)
+		assert.Equal(t, 2, v.calls)
+		assert.Equal(t, 2, cache.Len())
+	})
+
+	t.Run("different_rules", func(t *testing.T) {
+		v := &countingValidator{}
+		cache := NewResultCache(10)
+		data := map[string]any{"field": "valid"}
+
+		_, err := Validate(&Options{Data: data, Rules: RuleSet{{Path: "field", Rules: List{Required(), v}}}, Cache: cache})
+		require.Empty(t, err)
+		errs, err := Validate(&Options{Data: data, Rules: RuleSet{{Path: "field", Rules: List{Required(), Int()}}}, Cache: cache})
+		require.Empty(t, err)
+		assert.NotNil(t, errs)
+		assert.Equal(t, 2, cache.Len())
+	})
+
+	t.Run("converted_data", func(t *testing.T) {
+		rules := RuleSet{
+			{Path: "field", Rules: List{Required(), Int()}},
+		}
+		cache := NewResultCache(10)
+
+		opts := &Options{Data: map[string]any{"field": "12"}, Rules: rules, Cache: cache}
+		_, err := Validate(opts)
+		require.Empty(t, err)
+
+		opts2 := &Options{Data: map[string]any{"field": "12"}, Rules: rules, Cache: cache}
+		errs, err := Validate(opts2)
+		require.Empty(t, err)
+		assert.Nil(t, errs)
+		assert.Equal(t, map[string]any{"field": 12}, opts2.Data)
+	})
+
+	t.Run("new_rule_set_instance", func(t *testing.T) {
+		cache := NewResultCache(10)
+		v1 := &countingValidator{}
+		_, err := Validate(&Options{Data: map[string]any{"field": "invalid"}, Rules: RuleSet{{Path: "field", Rules: List{Required(), v1}}}, Cache: cache})
+		require.Empty(t, err)
+
+		v2 := &countingValidator{}
+		errs, err := Validate(&Options{Data: map[string]any{"field": "invalid"}, Rules: RuleSet{{Path: "field", Rules: List{Required(), v2}}}, Cache: cache})
+		require.Empty(t, err)
+		assert.NotNil(t, errs)
+		assert.Equal(t, 1, v1.calls)
+		assert.Equal(t, 0, v2.calls)
+		assert.Equal(t, 1, cache.Len())
+	})
+
+	t.Run("different_parameters", func(t *testing.T) {
+		cache := NewResultCache(10)
+		data := map[string]any{"field": 2}
+
+		errs, err := Validate(&Options{Data: data, Rules: RuleSet{{Path: "field", Rules: List{Required(), Int(), Min(1)}}}, Cache: cache})
+		require.Empty(t, err)
+		assert.Nil(t, errs)
+		errs, err = Validate(&Options{Data: data, Rules: RuleSet{{Path: "field", Rules: List{Required(), Int(), Min(3)}}}, Cache: cache})
+		require.Empty(t, err)
+		assert.NotNil(t, errs)
+		assert.Equal(t, 2, cache.Len())
+	})
+
+	t.Run("different_options", func(t *testing.T) {
+		cache := NewResultCache(10)
+		rules := func() RuleSet {
+			return RuleSet{{Path: "field", Rules: List{Required(), String()}}}
+		}
+
+		_, err := Validate(&Options{Data: map[string]any{"field": "a"}, Rules: rules(), Cache: cache, Method: http.MethodPost})
+		require.Empty(t, err)
+		_, err = Validate(&Options{Data: map[string]any{"field": "a"}, Rules: rules(), Cache: cache, Method: http.MethodPut})
+		require.Empty(t, err)
+		_, err = Validate(&Options{Data: map[string]any{"field": "a", "other": 1}, Rules: rules(), Cache: cache, Method: http.MethodPut, RejectUnknown: true})
+		require.Empty(t, err)
+		_, err = Validate(&Options{Data: map[string]any{"field": "a", "other": 1}, Rules: rules(), Cache: cache, Method: http.MethodPut, StripUnknown: true})
+		require.Empty(t, err)
+		_, err = Validate(&Options{Data: map[string]any{"field": "a"}, Rules: rules(), Cache: cache, Method: http.MethodPut, SkipTypeDependentOnTypeError: true})
+		require.Empty(t, err)
+		assert.Equal(t, 5, cache.Len())
+	})
+
+	t.Run("not_cacheable", func(t *testing.T) {
+		cache := NewResultCache(10)
+		rules := RuleSet{
+			{Path: "field", Rules: List{Required(), OnlyIf(func(_ *Context) bool { return true }, String())}},
+		}
+
+		errs, err := Validate(&Options{Data: map[string]any{"field": 1}, Rules: rules, Cache: cache, Language: lang.New().GetDefault()})
+		require.Empty(t, err)
+		assert.NotNil(t, errs)
+		assert.Equal(t, 0, cache.Len())
+	})
+
+	t.Run("data_copy", func(t *testing.T) {
+		rules := func() RuleSet {
+			return RuleSet{
+				{Path: "field", Rules: List{Required(), Array()}},
+				{Path: "field[]", Rules: List{Int()}},
+			}
+		}
+		cache := NewResultCache(10)
+
+		_, err := Validate(&Options{Data: map[string]any{"field": []any{"1", "2"}}, Rules: rules(), Cache: cache})
+		require.Empty(t, err)
+
+		opts := &Options{Data: map[string]any{"field": []any{"1", "2"}}, Rules: rules(), Cache: cache}
+		_, err = Validate(opts)
+		require.Empty(t, err)
+		assert.Equal(t, map[string]any{"field": []int{1, 2}}, opts.Data)
+		opts.Data.(map[string]any)["field"].([]int)[0] = 3
+
+		opts = &Options{Data: map[string]any{"field": []any{"1", "2"}}, Rules: rules(), Cache: cache}
+		_, err = Validate(opts)
+		require.Empty(t, err)
+		assert.Equal(t, map[string]any{"field": []int{1, 2}}, opts.Data)
+		assert.Equal(t, 1, cache.Len())
+	})
+
+	t.Run("key", func(t *testing.T) {
+		rules := RuleSet{{Path: "field", Rules: List{Required()}}}.AsRules()
+		key := func(data any) cacheKey {
+			k, ok := resultCacheKey(rules, &Options{Data: data})
+			require.True(t, ok)
+			return k
+		}
+
+		assert.Equal(t, key(map[string]any{"a": 1, "b": []any{"c"}}), key(map[string]any{"b": []any{"c"}, "a": 1}))
+		assert.NotEqual(t, key(map[string]any{"a": 1}), key(map[string]any{"a": 1.0}))
+		assert.NotEqual(t, key(map[string]any{"a": 1}), key(map[string]any{"a": "1"}))
+		assert.NotEqual(t, key(map[string]any{"a": []any{}}), key(map[string]any{"a": nil}))
+		assert.NotEqual(t, key(map[string]any{"a": "b,c"}), key(map[string]any{"a": "b", "c": nil}))
+
+		_, ok := resultCacheKey(rules, &Options{Data: map[string]any{"a": func() {}}})
+		assert.False(t, ok)
+	})
+
+	t.Run("eviction", func(t *testing.T) {
+		v := &countingValidator{}
+		rules := RuleSet{
+			{Path: "field", Rules: List{Required(), v}},
+		}
+		cache := NewResultCache(2)
+
+		validate := func(value string) {
+			_, err := Validate(&Options{Data: map[string]any{"field": value}, Rules: rules, Cache: cache})
+			require.Empty(t, err)
+		}
+
+		validate("a")
+		validate("b")
+		validate("a") // Hit, "b" becomes the least recently used
+		validate("c") // Evicts "b"
+		assert.Equal(t, 3, v.calls)
+		assert.Equal(t, 2, cache.Len())
+
+		validate("a")
+		assert.Equal(t, 3, v.calls)
+		validate("b")
+		assert.Equal(t, 4, v.calls)
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		cache := NewResultCache(5)
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				key := cacheKey{byte(i % 10)}
+				cache.put(&cacheEntry{key: key, data: i})
+				cache.get(key)
+			}(i)
+		}
+		wg.Wait()
+		assert.Equal(t, 5, cache.Len())
+		assert.Len(t, cache.entries, 5)
+	})
+}
@@ -0,0 +1,178 @@
+package validation
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	hexColorRegex      = regexp.MustCompile(`^#(?:[0-9a-f]{3,4}|[0-9a-f]{6}|[0-9a-f]{8})$`)
+	colorFunctionRegex = regexp.MustCompile(`^(rgba?|hsla?)\((.*)\)$`)
+	cssNumberRegex     = regexp.MustCompile(`^[+-]?(?:\d+(?:\.\d*)?|\.\d+)$`)
+)
+
+// CSSNamedColors the color keywords accepted by `CSSColorValidator`, in lowercase.
+var CSSNamedColors = []string{
+	"aliceblue", "antiquewhite", "aqua", "aquamarine", "azure", "beige", "bisque", "black",
+	"blanchedalmond", "blue", "blueviolet", "brown", "burlywood", "cadetblue", "chartreuse",
+	"chocolate", "coral", "cornflowerblue", "cornsilk", "crimson", "cyan", "darkblue", "darkcyan",
+	"darkgoldenrod", "darkgray", "darkgreen", "darkgrey", "darkkhaki", "darkmagenta",
+	"darkolivegreen", "darkorange", "darkorchid", "darkred", "darksalmon", "darkseagreen",
+	"darkslateblue", "darkslategray", "darkslategrey", "darkturquoise", "darkviolet", "deeppink",
+	"deepskyblue", "dimgray", "dimgrey", "dodgerblue", "firebrick", "floralwhite", "forestgreen",
+	"fuchsia", "gainsboro", "ghostwhite", "gold", "goldenrod", "gray", "green", "greenyellow",
+	"grey", "honeydew", "hotpink", "indianred", "indigo", "ivory", "khaki", "lavender",
+	"lavenderblush", "lawngreen", "lemonchiffon", "lightblue", "lightcoral", "lightcyan",
+	"lightgoldenrodyellow", "lightgray", "lightgreen", "lightgrey", "lightpink", "lightsalmon",
+	"lightseagreen", "lightskyblue", "lightslategray", "lightslategrey", "lightsteelblue",
+	"lightyellow", "lime", "limegreen", "linen", "magenta", "maroon", "mediumaquamarine",
+	"mediumblue", "mediumorchid", "mediumpurple", "mediumseagreen", "mediumslateblue",
+	"mediumspringgreen", "mediumturquoise", "mediumvioletred", "midnightblue", "mintcream",
+	"mistyrose", "moccasin", "navajowhite", "navy", "oldlace", "olive", "olivedrab", "orange",
+	"orangered", "orchid", "palegoldenrod", "palegreen", "paleturquoise", "palevioletred",
+	"papayawhip", "peachpuff", "peru", "pink", "plum", "powderblue", "purple", "rebeccapurple",
+	"red", "rosybrown", "royalblue", "saddlebrown", "salmon", "sandybrown", "seagreen",
+	"seashell", "sienna", "silver", "skyblue", "slateblue", "slategray", "slategrey", "snow",
+	"springgreen", "steelblue", "tan", "teal", "thistle", "tomato", "transparent", "turquoise",
+	"violet", "wheat", "white", "whitesmoke", "yellow", "yellowgreen",
+}
+
+var cssNamedColors = func() map[string]struct{} {
+	m := make(map[string]struct{}, len(CSSNamedColors))
+	for _, c := range CSSNamedColors {
+		m[c] = struct{}{}
+	}
+	return m
+}()
+
+// CSSColorValidator validates the field under validation must be a string representing
+// a CSS color: a hexadecimal color (e.g. "#fff", "#ff000080"), a `rgb()`, `rgba()`,
+// `hsl()` or `hsla()` function, or a named color (see `CSSNamedColors`).
+// The validation is case-insensitive.
+type CSSColorValidator struct{ BaseValidator }
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *CSSColorValidator) Validate(ctx *Context) bool {
+	str, ok := ctx.Value.(string)
+	if !ok {
+		return false
+	}
+	str = strings.ToLower(strings.TrimSpace(str))
+	if strings.HasPrefix(str, "#") {
+		return hexColorRegex.MatchString(str)
+	}
+	if _, ok := cssNamedColors[str]; ok {
+		return true
+	}
+
+	match := colorFunctionRegex.FindStringSubmatch(str)
+	if match == nil {
+		return false
+	}
+	components, alpha, ok := splitColorComponents(match[2])
+	if !ok || (alpha != "" && !isCSSAlpha(alpha)) {
+		return false
+	}
+	if strings.HasPrefix(match[1], "rgb") {
+		return isRGBComponents(components)
+	}
+	return isHSLComponents(components)
+}
+
+// splitColorComponents splits the arguments of a color function, either
+// written using the legacy comma-separated syntax ("255, 0, 0, 0.5") or the
+// space-separated syntax ("255 0 0 / 50%"). Exactly three components are expected.
+func splitColorComponents(args string) (components []string, alpha string, ok bool) {
+	if strings.Contains(args, ",") {
+		components = strings.Split(args, ",")
+		for i, c := range components {
+			components[i] = strings.TrimSpace(c)
+		}
+		if len(components) == 4 {
+			alpha = components[3]
+			if alpha == "" {
+				return nil, "", false
+			}
+			components = components[:3]
+		}
+		return components, alpha, len(components) == 3
+	}
+
+	args, alpha, hasAlpha := strings.Cut(args, "/")
+	alpha = strings.TrimSpace(alpha)
+	if hasAlpha && alpha == "" {
+		return nil, "", false
+	}
+	components = strings.Fields(args)
+	return components, alpha, len(components) == 3
+}
+
+// parseCSSNumber parses a CSS number with an optional "%" unit.
+func parseCSSNumber(str string) (n float64, percent bool, ok bool) {
+	str, percent = strings.CutSuffix(str, "%")
+	if !cssNumberRegex.MatchString(str) {
+		return 0, false, false
+	}
+	n, err := strconv.ParseFloat(str, 64)
+	return n, percent, err == nil
+}
+
+func isCSSAlpha(str string) bool {
+	n, percent, ok := parseCSSNumber(str)
+	if !ok {
+		return false
+	}
+	if percent {
+		return n >= 0 && n <= 100
+	}
+	return n >= 0 && n <= 1
+}
+
+func isRGBComponents(components []string) bool {
+	var firstPercent bool
+	for i, c := range components {
+		n, percent, ok := parseCSSNumber(c)
+		if !ok {
+			return false
+		}
+		if i == 0 {
+			firstPercent = percent
+		} else if percent != firstPercent { // Numbers and percentages cannot be mixed
+			return false
+		}
+		if (percent && (n < 0 || n > 100)) || (!percent && (n < 0 || n > 255)) {
+			return false
+		}
+	}
+	return true
+}
+
+func isHSLComponents(components []string) bool {
+	hue, _ := strings.CutSuffix(components[0], "deg")
+	if !cssNumberRegex.MatchString(hue) {
+		return false
+	}
+	for _, c := range components[1:] {
+		n, percent, ok := parseCSSNumber(c)
+		if !ok || !percent || n < 0 || n > 100 {
+			return false
+		}
+	}
+	return true
+}
+
+// Name returns the string name of the validator.
+func (v *CSSColorValidator) Name() string { return "css_color" }
+
+// CSSColor the field under validation must be a string representing a CSS color:
+//   - a hexadecimal color with or without alpha channel: "#fff", "#ffff", "#ff0000", "#ff000080"
+//   - a `rgb()` or `rgba()` function: "rgb(255, 0, 0)", "rgba(100%, 0%, 0%, 0.5)", "rgb(255 0 0 / 50%)"
+//   - a `hsl()` or `hsla()` function: "hsl(120, 100%, 50%)", "hsl(120deg 100% 50% / 0.5)"
+//   - a named color: "red", "rebeccapurple", "transparent" (see `CSSNamedColors`)
+//
+// Values out of range (such as "rgb(300, 0, 0)") don't pass. The validation is case-insensitive.
+// Other color spaces and relative colors are not supported.
+func CSSColor() *CSSColorValidator {
+	return &CSSColorValidator{}
+}
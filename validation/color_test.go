@@ -0,0 +1,73 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSSColorValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := CSSColor()
+		assert.NotNil(t, v)
+		assert.Equal(t, "css_color", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+	})
+
+	cases := []struct {
+		value any
+		want  bool
+	}{
+		{value: "#fff", want: true},
+		{value: "#FFFA", want: true},
+		{value: "#ff0000", want: true},
+		{value: "#ff000080", want: true},
+		{value: "rgb(255,0,0)", want: true},
+		{value: "rgb(255, 0, 0)", want: true},
+		{value: "RGB(255, 0, 0)", want: true},
+		{value: "rgba(255, 0, 0, 0.5)", want: true},
+		{value: "rgba(100%, 0%, 50.5%, 50%)", want: true},
+		{value: "rgb(255 0 0)", want: true},
+		{value: "rgb(255 0 0 / .5)", want: true},
+		{value: "hsl(120, 100%, 50%)", want: true},
+		{value: "hsla(-120, 100%, 50%, 1)", want: true},
+		{value: "hsl(120deg 100% 50% / 50%)", want: true},
+		{value: "rebeccapurple", want: true},
+		{value: "RebeccaPurple", want: true},
+		{value: " red ", want: true},
+		{value: "transparent", want: true},
+		{value: "#ff", want: false},
+		{value: "#fffff", want: false},
+		{value: "#ggg", want: false},
+		{value: "fff", want: false},
+		{value: "rgb(300,0,0)", want: false},
+		{value: "rgb(-1, 0, 0)", want: false},
+		{value: "rgb(101%, 0%, 0%)", want: false},
+		{value: "rgb(255, 0%, 0)", want: false},
+		{value: "rgb(255, 0)", want: false},
+		{value: "rgb(255, 0, 0, 0, 0)", want: false},
+		{value: "rgba(255, 0, 0, 2)", want: false},
+		{value: "rgba(255, 0, 0, )", want: false},
+		{value: "rgb(255 0 0 /)", want: false},
+		{value: "rgb(a, b, c)", want: false},
+		{value: "rgb(1e2, 0, 0)", want: false},
+		{value: "rgb(255, 0, 0", want: false},
+		{value: "hsl(120, 100, 50)", want: false},
+		{value: "hsl(120, 101%, 50%)", want: false},
+		{value: "hsl(abc, 100%, 50%)", want: false},
+		{value: "notacolor", want: false},
+		{value: "", want: false},
+		{value: 0xffffff, want: false},
+		{value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%t", c.value, c.want), func(t *testing.T) {
+			v := CSSColor()
+			assert.Equal(t, c.want, v.Validate(&Context{Value: c.value}))
+		})
+	}
+}
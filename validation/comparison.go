@@ -2,6 +2,7 @@ package validation
 
 import (
 	"fmt"
+	"reflect"
 
 	"goyave.dev/goyave/v5/util/errors"
 	"goyave.dev/goyave/v5/util/walk"
@@ -15,6 +16,11 @@ import (
 //   - Compare a numeric field with the number of elements in an array
 //   - Compare the number of keys in an object with a numeric field
 //   - Compare a file (or multifile) size with a numeric field. The number of KiB of each file is rounded up (ceil).
+//
+// If the path contains arrays or wildcards, the field under validation must satisfy the comparison
+// with every matched element. If an array (or an object matched by a wildcard) is empty,
+// no element is matched and the validator passes. Missing fields (including the arrays
+// themselves) don't pass.
 type ComparisonValidator struct {
 	Path *walk.Path
 	BaseValidator
@@ -29,8 +35,7 @@ func (v *ComparisonValidator) validate(ctx *Context, comparisonFunc func(size1,
 
 	ok := true
 	v.Path.Walk(ctx.Data, func(c *walk.Context) {
-		lastParent := c.Path.LastParent()
-		if lastParent != nil && lastParent.Type == walk.PathTypeArray && c.Found == walk.ElementNotFound {
+		if isEmptyCollection(c) {
 			return
 		}
 
@@ -76,6 +81,24 @@ func (v *ComparisonValidator) validate(ctx *Context, comparisonFunc func(size1,
 	return ok
 }
 
+// isEmptyCollection returns true if the element of the given walk context doesn't exist
+// because the array (or the object matched by a wildcard) containing it is empty.
+// The validators comparing the field under validation with the elements matched by a path
+// pass if there is no such element.
+func isEmptyCollection(c *walk.Context) bool {
+	if c.Found == walk.Found {
+		return false
+	}
+	parent := reflect.ValueOf(c.Parent)
+	switch parent.Kind() {
+	case reflect.Slice:
+		return parent.Len() == 0
+	case reflect.Map:
+		return parent.Len() == 0 && c.Name == "*"
+	}
+	return false
+}
+
 // IsTypeDependent returns true
 func (v *ComparisonValidator) IsTypeDependent() bool { return true }
 
@@ -113,11 +136,21 @@ func (v *GreaterThanValidator) Name() string { return "greater_than" }
 //   - Compare the number of keys in an object with a numeric field
 //   - Compare a file (or multifile) size with a numeric field. The number of KiB of each file is rounded up (ceil).
 func GreaterThan(path string) *GreaterThanValidator {
+	v, err := GreaterThanE(path)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// GreaterThanE is the same as `GreaterThan()` but returns an error instead of panicking
+// if the given path cannot be parsed.
+func GreaterThanE(path string) (*GreaterThanValidator, error) {
 	p, err := walk.Parse(path)
 	if err != nil {
-		panic(errors.NewSkip(fmt.Errorf("validation.GreaterThan: path parse error: %w", err), 3))
+		return nil, fmt.Errorf("validation.GreaterThan: path parse error: %w", err)
 	}
-	return &GreaterThanValidator{ComparisonValidator: ComparisonValidator{Path: p}}
+	return &GreaterThanValidator{ComparisonValidator: ComparisonValidator{Path: p}}, nil
 }
 
 //------------------------------
@@ -147,11 +180,21 @@ func (v *GreaterThanEqualValidator) Name() string { return "greater_than_equal"
 //   - Compare the number of keys in an object with a numeric field
 //   - Compare a file (or multifile) size with a numeric field. The number of KiB of each file is rounded up (ceil).
 func GreaterThanEqual(path string) *GreaterThanEqualValidator {
+	v, err := GreaterThanEqualE(path)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// GreaterThanEqualE is the same as `GreaterThanEqual()` but returns an error instead of panicking
+// if the given path cannot be parsed.
+func GreaterThanEqualE(path string) (*GreaterThanEqualValidator, error) {
 	p, err := walk.Parse(path)
 	if err != nil {
-		panic(errors.NewSkip(fmt.Errorf("validation.GreaterThanEqual: path parse error: %w", err), 3))
+		return nil, fmt.Errorf("validation.GreaterThanEqual: path parse error: %w", err)
 	}
-	return &GreaterThanEqualValidator{ComparisonValidator: ComparisonValidator{Path: p}}
+	return &GreaterThanEqualValidator{ComparisonValidator: ComparisonValidator{Path: p}}, nil
 }
 
 //------------------------------
@@ -181,11 +224,21 @@ func (v *LowerThanValidator) Name() string { return "lower_than" }
 //   - Compare the number of keys in an object with a numeric field
 //   - Compare a file (or multifile) size with a numeric field. The number of KiB of each file is rounded up (ceil).
 func LowerThan(path string) *LowerThanValidator {
+	v, err := LowerThanE(path)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// LowerThanE is the same as `LowerThan()` but returns an error instead of panicking
+// if the given path cannot be parsed.
+func LowerThanE(path string) (*LowerThanValidator, error) {
 	p, err := walk.Parse(path)
 	if err != nil {
-		panic(errors.NewSkip(fmt.Errorf("validation.LowerThan: path parse error: %w", err), 3))
+		return nil, fmt.Errorf("validation.LowerThan: path parse error: %w", err)
 	}
-	return &LowerThanValidator{ComparisonValidator: ComparisonValidator{Path: p}}
+	return &LowerThanValidator{ComparisonValidator: ComparisonValidator{Path: p}}, nil
 }
 
 //------------------------------
@@ -215,9 +268,19 @@ func (v *LowerThanEqualValidator) Name() string { return "lower_than_equal" }
 //   - Compare the number of keys in an object with a numeric field
 //   - Compare a file (or multifile) size with a numeric field. The number of KiB of each file is rounded up (ceil).
 func LowerThanEqual(path string) *LowerThanEqualValidator {
+	v, err := LowerThanEqualE(path)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// LowerThanEqualE is the same as `LowerThanEqual()` but returns an error instead of panicking
+// if the given path cannot be parsed.
+func LowerThanEqualE(path string) (*LowerThanEqualValidator, error) {
 	p, err := walk.Parse(path)
 	if err != nil {
-		panic(errors.NewSkip(fmt.Errorf("validation.LowerThanEqual: path parse error: %w", err), 3))
+		return nil, fmt.Errorf("validation.LowerThanEqual: path parse error: %w", err)
 	}
-	return &LowerThanEqualValidator{ComparisonValidator: ComparisonValidator{Path: p}}
+	return &LowerThanEqualValidator{ComparisonValidator: ComparisonValidator{Path: p}}, nil
 }
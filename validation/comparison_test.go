@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"goyave.dev/goyave/v5/lang"
 	"goyave.dev/goyave/v5/util/fsutil"
 )
@@ -33,6 +34,13 @@ func TestGreaterThanValidator(t *testing.T) {
 		assert.Panics(t, func() {
 			GreaterThan("invalid[path.")
 		})
+
+		v2, err := GreaterThanE(path)
+		require.NoError(t, err)
+		assert.Equal(t, path, v2.Path.String())
+		v2, err = GreaterThanE("invalid[path.")
+		require.Error(t, err)
+		assert.Nil(t, v2)
 	})
 
 	largeFile := fsutil.File{
@@ -183,6 +191,13 @@ func TestGreaterThanEqualValidator(t *testing.T) {
 		assert.Panics(t, func() {
 			GreaterThanEqual("invalid[path.")
 		})
+
+		v2, err := GreaterThanEqualE(path)
+		require.NoError(t, err)
+		assert.Equal(t, path, v2.Path.String())
+		v2, err = GreaterThanEqualE("invalid[path.")
+		require.Error(t, err)
+		assert.Nil(t, v2)
 	})
 
 	largeFile := fsutil.File{
@@ -343,6 +358,34 @@ func TestGreaterThanEqualValidator(t *testing.T) {
 			}))
 		})
 	}
+
+	t.Run("bound_from_converted_field", func(t *testing.T) {
+		// The bound field is validated (and converted) first, so the compared
+		// value is numeric even if it was sent as a string (url-encoded form for example).
+		rules := func() RuleSet {
+			return RuleSet{
+				{Path: "min_quantity", Rules: List{Required(), Int()}},
+				{Path: "quantity", Rules: List{Required(), Int(), GreaterThanEqual("min_quantity")}},
+			}
+		}
+
+		errs, err := Validate(&Options{
+			Data:     map[string]any{"min_quantity": "10", "quantity": "12"},
+			Rules:    rules(),
+			Language: lang.New().GetDefault(),
+		})
+		require.Empty(t, err)
+		assert.Nil(t, errs)
+
+		errs, err = Validate(&Options{
+			Data:     map[string]any{"min_quantity": "10", "quantity": "9"},
+			Rules:    rules(),
+			Language: lang.New().GetDefault(),
+		})
+		require.Empty(t, err)
+		require.NotNil(t, errs)
+		assert.Equal(t, []string{"The quantity must be greater or equal to the min_quantity."}, errs.Fields["quantity"].Errors)
+	})
 }
 
 func TestLowerThanValidator(t *testing.T) {
@@ -359,6 +402,13 @@ func TestLowerThanValidator(t *testing.T) {
 		assert.Panics(t, func() {
 			LowerThan("invalid[path.")
 		})
+
+		v2, err := LowerThanE(path)
+		require.NoError(t, err)
+		assert.Equal(t, path, v2.Path.String())
+		v2, err = LowerThanE("invalid[path.")
+		require.Error(t, err)
+		assert.Nil(t, v2)
 	})
 
 	largeFile := fsutil.File{
@@ -509,6 +559,13 @@ func TestLowerThanEqualValidator(t *testing.T) {
 		assert.Panics(t, func() {
 			LowerThanEqual("invalid[path.")
 		})
+
+		v2, err := LowerThanEqualE(path)
+		require.NoError(t, err)
+		assert.Equal(t, path, v2.Path.String())
+		v2, err = LowerThanEqualE("invalid[path.")
+		require.Error(t, err)
+		assert.Nil(t, v2)
 	})
 
 	largeFile := fsutil.File{
@@ -670,3 +727,51 @@ func TestLowerThanEqualValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestFieldComparisonValidatorsZeroMatches(t *testing.T) {
+	date := time.Date(2023, 3, 15, 10, 7, 42, 0, time.UTC)
+	cases := []struct {
+		validator func(path string) Validator
+		value     any
+		desc      string
+	}{
+		{desc: "greater_than", value: 2, validator: func(path string) Validator { return GreaterThan(path) }},
+		{desc: "greater_than_equal", value: 2, validator: func(path string) Validator { return GreaterThanEqual(path) }},
+		{desc: "lower_than", value: 2, validator: func(path string) Validator { return LowerThan(path) }},
+		{desc: "lower_than_equal", value: 2, validator: func(path string) Validator { return LowerThanEqual(path) }},
+		{desc: "same", value: "a", validator: func(path string) Validator { return Same(path) }},
+		{desc: "same_array", value: []string{"a"}, validator: func(path string) Validator { return SameArray(path) }},
+		{desc: "different", value: "a", validator: func(path string) Validator { return Different(path) }},
+		{desc: "after_field", value: date, validator: func(path string) Validator { return AfterField(path) }},
+		{desc: "after_equal_field", value: date, validator: func(path string) Validator { return AfterEqualField(path) }},
+		{desc: "before_field", value: date, validator: func(path string) Validator { return BeforeField(path) }},
+		{desc: "before_equal_field", value: date, validator: func(path string) Validator { return BeforeEqualField(path) }},
+		{desc: "date_equals_field", value: date, validator: func(path string) Validator { return DateEqualsField(path) }},
+		{desc: "sum_equals_field", value: []any{1, 2}, validator: func(path string) Validator { return SumEqualsField(path) }},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			// Every validator follows the same rule: empty arrays (or objects matched by
+			// a wildcard) match nothing and pass, missing fields don't pass.
+			for _, path := range []string{"items.*.value", "items[].value", "values.*", "values[]"} {
+				assert.True(t, c.validator(path).Validate(&Context{
+					Value: c.value,
+					Data:  map[string]any{"items": []any{}, "values": []any{}},
+				}), path)
+				assert.False(t, c.validator(path).Validate(&Context{
+					Value: c.value,
+					Data:  map[string]any{},
+				}), path)
+			}
+			assert.True(t, c.validator("items.*.value").Validate(&Context{
+				Value: c.value,
+				Data:  map[string]any{"items": map[string]any{}},
+			}))
+			assert.False(t, c.validator("items.*.value").Validate(&Context{
+				Value: c.value,
+				Data:  map[string]any{"items": []any{map[string]any{}}},
+			}))
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package validation
+
+import (
+	"reflect"
+	"sync"
+
+	"goyave.dev/goyave/v5/util/walk"
+)
+
+// CrossFieldValidator can be implemented by custom validators reading other fields
+// than the field under validation (using `Context.Data` or `Context.Lookup()` for example).
+// Fields using such validators are not validated concurrently when `Options.Concurrency`
+// is enabled.
+//
+// Validators having a `*walk.Path` or `[]*walk.Path` field are considered cross-field
+// without implementing this interface.
+type CrossFieldValidator interface {
+	IsCrossField() bool
+}
+
+var (
+	pathType  = reflect.TypeOf((*walk.Path)(nil))
+	pathsType = reflect.TypeOf([]*walk.Path(nil))
+)
+
+// fieldGroup the rules of a single top-level field.
+type fieldGroup struct {
+	name  string
+	rules Rules
+}
+
+// validateConcurrently validates the independent top-level fields concurrently, then
+// validates the other fields sequentially. See `Options.Concurrency`.
+func (v *validator) validateConcurrently(rules Rules) {
+	data, ok := v.options.Data.(map[string]any)
+	if !ok {
+		v.validateRules(rules)
+		return
+	}
+
+	groups, sequential := groupIndependentFields(rules)
+	if len(groups) < 2 {
+		v.validateRules(rules)
+		return
+	}
+
+	// Each group works on its own root object so the workers
+	// never write in the same map.
+	groupData := make([]map[string]any, len(groups))
+	for i, group := range groups {
+		groupData[i] = map[string]any{}
+		if value, ok := data[group.name]; ok {
+			groupData[i][group.name] = value
+		}
+	}
+
+	results := make([]*validator, len(groups))
+	indexes := make(chan int)
+	workers := min(v.options.Concurrency, len(groups))
+	wg := sync.WaitGroup{}
+	wg.Add(workers)
+	for range workers {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = v.validateGroup(groups[i], groupData[i])
+			}
+		}()
+	}
+	for i := range groups {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	root := &walk.Path{Type: walk.PathTypeElement}
+	for i, group := range groups {
+		result := results[i]
+		v.errors = append(v.errors, result.errors...)
+		v.validationErrors.Merge(root, result.validationErrors)
+		v.warnings.Merge(root, result.warnings)
+		if value, ok := groupData[i][group.name]; ok {
+			data[group.name] = value
+		} else {
+			delete(data, group.name)
+		}
+	}
+
+	v.validateRules(sequential)
+}
+
+// validateGroup validates the given group using a new validator sharing the same
+// options (except the data) and the same error count.
+func (v *validator) validateGroup(group *fieldGroup, data map[string]any) *validator {
+	opts := *v.options
+	opts.Data = data
+	groupValidator := &validator{
+		options:          &opts,
+		now:              v.now,
+		errors:           []error{},
+		validationErrors: &Errors{},
+		warnings:         &Errors{},
+		errorCount:       v.errorCount,
+	}
+	groupValidator.validateRules(group.rules)
+	return groupValidator
+}
+
+// groupIndependentFields groups the rules by top-level field. The groups containing
+// at least one cross-field validator, or which cannot be identified by a top-level
+// field name (root element or wildcard) are returned in the "sequential" rules instead.
+// The order of the rules is preserved.
+func groupIndependentFields(rules Rules) (groups []*fieldGroup, sequential Rules) {
+	dependent := map[string]bool{}
+	for _, field := range rules {
+		name, ok := topLevelName(field)
+		if !ok {
+			continue
+		}
+		dependent[name] = dependent[name] || hasCrossFieldValidator(field)
+	}
+
+	groupsByName := map[string]*fieldGroup{}
+	for _, field := range rules {
+		name, ok := topLevelName(field)
+		if !ok || dependent[name] {
+			sequential = append(sequential, field)
+			continue
+		}
+		group, ok := groupsByName[name]
+		if !ok {
+			group = &fieldGroup{name: name}
+			groupsByName[name] = group
+			groups = append(groups, group)
+		}
+		group.rules = append(group.rules, field)
+	}
+	return groups, sequential
+}
+
+func topLevelName(field *Field) (string, bool) {
+	if field.Path.Name == nil || *field.Path.Name == CurrentElement || field.Path.IsWildcard() {
+		return "", false
+	}
+	return *field.Path.Name, true
+}
+
+func hasCrossFieldValidator(field *Field) bool {
+	for _, v := range field.Validators {
+		if isCrossField(v) {
+			return true
+		}
+	}
+	return field.Elements != nil && hasCrossFieldValidator(field.Elements)
+}
+
+func isCrossField(v Validator) bool {
+	if c, ok := v.(CrossFieldValidator); ok {
+		return c.IsCrossField()
+	}
+	switch v.(type) {
+	case *RequiredIfValidator, *RequiredIfMatchesValidator, *OnlyIfValidator:
+		return true
+	}
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if value.Kind() != reflect.Struct {
+		return false
+	}
+	for i := range value.NumField() {
+		if t := value.Field(i).Type(); t == pathType || t == pathsType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,179 @@
+package validation
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goyave.dev/goyave/v5/lang"
+)
+
+type crossFieldTestValidator struct {
+	testValidator
+}
+
+func (v *crossFieldTestValidator) IsCrossField() bool { return true }
+
+// lookupValidator simulates a slow validator (a database lookup for example).
+func lookupValidator(delay time.Duration, calls *atomic.Int64) *testValidator {
+	return &testValidator{
+		validateFunc: func(_ component, ctx *Context) bool {
+			calls.Add(1)
+			time.Sleep(delay)
+			str, ok := ctx.Value.(string)
+			return ok && str != "taken"
+		},
+	}
+}
+
+func concurrencyTestOptions(concurrency int, delay time.Duration, calls *atomic.Int64) *Options {
+	return &Options{
+		Data: map[string]any{
+			"username": "taken",
+			"email":    "john@example.org",
+			"slug":     "taken",
+			"age":      "12",
+			"tags":     []any{"taken", "b", "taken"},
+			"nullable": nil,
+		},
+		Language:    lang.New().GetDefault(),
+		Concurrency: concurrency,
+		Rules: RuleSet{
+			{Path: "username", Rules: List{Required(), String(), lookupValidator(delay, calls)}},
+			{Path: "email", Rules: List{Required(), String(), Email(), lookupValidator(delay, calls)}},
+			{Path: "slug", Rules: List{Required(), String(), lookupValidator(delay, calls)}},
+			{Path: "age", Rules: List{Required(), Int(), Min(18)}},
+			{Path: "tags", Rules: List{Required(), Array()}},
+			{Path: "tags[]", Rules: List{String(), lookupValidator(delay, calls)}},
+			{Path: "nullable", Rules: List{String()}},
+			{Path: "older", Rules: List{Int(), GreaterThan("age")}},
+		},
+	}
+}
+
+func TestValidateConcurrency(t *testing.T) {
+	t.Run("same_result_as_sequential", func(t *testing.T) {
+		var sequentialCalls atomic.Int64
+		sequential := concurrencyTestOptions(0, time.Millisecond, &sequentialCalls)
+		wantErrors, errs := Validate(sequential)
+		require.Empty(t, errs)
+		require.NotNil(t, wantErrors)
+
+		var calls atomic.Int64
+		opts := concurrencyTestOptions(4, time.Millisecond, &calls)
+		validationErrors, errs := Validate(opts)
+		require.Empty(t, errs)
+		assert.Equal(t, wantErrors, validationErrors)
+		assert.Equal(t, sequential.Data, opts.Data)
+		assert.Equal(t, int64(6), calls.Load())
+		assert.Equal(t, 12, opts.Data.(map[string]any)["age"]) // Converted
+		assert.NotContains(t, opts.Data.(map[string]any), "nullable")
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		var calls atomic.Int64
+		opts := concurrencyTestOptions(4, 100*time.Millisecond, &calls)
+		start := time.Now()
+		validationErrors, errs := Validate(opts)
+		require.Empty(t, errs)
+		require.NotNil(t, validationErrors)
+		// The tags elements are validated sequentially, the other lookups concurrently.
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+
+	t.Run("cross_field_after_independent_fields", func(t *testing.T) {
+		opts := &Options{
+			Data:        map[string]any{"min": "10", "max": "5", "other": "a"},
+			Language:    lang.New().GetDefault(),
+			Concurrency: 4,
+			Rules: RuleSet{
+				{Path: "max", Rules: List{Required(), Int(), GreaterThan("min")}},
+				{Path: "min", Rules: List{Required(), Int()}},
+				{Path: "other", Rules: List{Required(), String()}},
+			},
+		}
+		validationErrors, errs := Validate(opts)
+		require.Empty(t, errs)
+		require.NotNil(t, validationErrors)
+		// "min" was converted before "max" was validated.
+		assert.Equal(t, []string{"The max must be greater than the min."}, validationErrors.Fields["max"].Errors)
+		assert.Equal(t, map[string]any{"min": 10, "max": 5, "other": "a"}, opts.Data)
+	})
+
+	t.Run("not_an_object", func(t *testing.T) {
+		opts := &Options{
+			Data:        "a",
+			Language:    lang.New().GetDefault(),
+			Concurrency: 4,
+			Rules: RuleSet{
+				{Path: CurrentElement, Rules: List{Required(), Int()}},
+			},
+		}
+		validationErrors, errs := Validate(opts)
+		require.Empty(t, errs)
+		require.NotNil(t, validationErrors)
+		assert.Equal(t, []string{"The body must be an integer."}, validationErrors.Errors)
+	})
+
+	t.Run("max_errors", func(t *testing.T) {
+		var calls atomic.Int64
+		opts := concurrencyTestOptions(4, 0, &calls)
+		opts.MaxErrors = 2
+		validationErrors, errs := Validate(opts)
+		require.Empty(t, errs)
+		require.NotNil(t, validationErrors)
+		count := 0
+		for _, field := range validationErrors.Fields {
+			count += len(field.Errors)
+			for _, element := range field.Elements {
+				count += len(element.Errors)
+			}
+		}
+		assert.Equal(t, 2, count)
+	})
+}
+
+func TestGroupIndependentFields(t *testing.T) {
+	crossField := &crossFieldTestValidator{}
+	rules := RuleSet{
+		{Path: "a", Rules: List{Required(), Object()}},
+		{Path: "b", Rules: List{Required(), Same("a")}},
+		{Path: "a.b", Rules: List{Required(), String()}},
+		{Path: "c", Rules: List{Required(), Array()}},
+		{Path: "c[]", Rules: List{RequiredIf(func(_ *Context) bool { return true })}},
+		{Path: "d", Rules: List{Required(), Array()}},
+		{Path: "d[]", Rules: List{MutuallyExclusive("x", "y")}},
+		{Path: "e", Rules: List{OnlyIf(func(_ *Context) bool { return true }, String())}},
+		{Path: "f", Rules: List{crossField}},
+		{Path: "g", Rules: List{String()}},
+		{Path: CurrentElement, Rules: List{Required(), Object()}},
+	}.AsRules()
+
+	groups, sequential := groupIndependentFields(rules)
+	require.Len(t, groups, 2)
+	assert.Equal(t, "a", groups[0].name)
+	assert.Len(t, groups[0].rules, 2)
+	assert.Equal(t, "g", groups[1].name)
+	assert.Len(t, groups[1].rules, 1)
+
+	names := make([]string, 0, len(sequential))
+	for _, field := range sequential {
+		names = append(names, field.Path.String())
+	}
+	assert.Equal(t, []string{"b", "c", "d", "e", "f", ""}, names)
+}
+
+func BenchmarkValidateConcurrency(b *testing.B) {
+	for _, concurrency := range []int{0, 4} {
+		b.Run(fmt.Sprintf("concurrency_%d", concurrency), func(b *testing.B) {
+			b.ReportAllocs()
+			var calls atomic.Int64
+			for b.Loop() {
+				_, _ = Validate(concurrencyTestOptions(concurrency, time.Millisecond, &calls))
+			}
+		})
+	}
+}
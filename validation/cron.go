@@ -0,0 +1,116 @@
+package validation
+
+import (
+	"strconv"
+	"strings"
+)
+
+// cronField the allowed range and optional names of a cron expression field.
+type cronField struct {
+	names []string // Index 0 corresponds to "min"
+	min   int
+	max   int
+}
+
+var (
+	cronSeconds = cronField{min: 0, max: 59}
+	cronFields  = []cronField{
+		{min: 0, max: 59}, // Minutes
+		{min: 0, max: 23}, // Hours
+		{min: 1, max: 31}, // Day of month
+		{min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+		{min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}}, // 0 and 7 are Sunday
+	}
+)
+
+// CronValidator validates the field under validation must be a string representing
+// a valid cron expression. See `Cron()`.
+type CronValidator struct {
+	BaseValidator
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *CronValidator) Validate(ctx *Context) bool {
+	str, ok := ctx.Value.(string)
+	if !ok {
+		return false
+	}
+
+	fields := strings.Fields(str)
+	switch len(fields) {
+	case len(cronFields):
+	case len(cronFields) + 1:
+		if !cronSeconds.validate(fields[0]) {
+			return false
+		}
+		fields = fields[1:]
+	default:
+		return false
+	}
+
+	for i, field := range fields {
+		if !cronFields[i].validate(field) {
+			return false
+		}
+	}
+	return true
+}
+
+// validate checks a comma-separated list of values, ranges ("a-b") and steps ("*/n", "a-b/n", "a/n").
+func (f cronField) validate(field string) bool {
+	for item := range strings.SplitSeq(field, ",") {
+		rng, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n < 1 || n > f.max {
+				return false
+			}
+		}
+		if rng == "*" {
+			continue
+		}
+		low, high, isRange := strings.Cut(rng, "-")
+		lowValue, ok := f.value(low)
+		if !ok {
+			return false
+		}
+		if isRange {
+			highValue, ok := f.value(high)
+			if !ok || highValue < lowValue {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (f cronField) value(str string) (int, bool) {
+	for i, name := range f.names {
+		if strings.EqualFold(str, name) {
+			return f.min + i, true
+		}
+	}
+	if str == "" || strings.TrimLeft(str, "0123456789") != "" {
+		return 0, false
+	}
+	n, err := strconv.Atoi(str)
+	if err != nil || n < f.min || n > f.max {
+		return 0, false
+	}
+	return n, true
+}
+
+// Name returns the string name of the validator.
+func (v *CronValidator) Name() string { return "cron" }
+
+// Cron the field under validation must be a string representing a valid cron expression
+// made of five fields (minute, hour, day of month, month and day of week), or six fields
+// if the expression starts with the seconds.
+//
+// Each field accepts "*", values, ranges ("1-5"), steps ("*/15", "0-30/5") and comma-separated
+// lists of them ("1,15,30"). Months and days of the week can also be written using their
+// three-letter English abbreviations ("JAN", "MON"), case-insensitively.
+// Sunday can be written either as 0 or 7.
+func Cron() *CronValidator {
+	return &CronValidator{}
+}
@@ -0,0 +1,64 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCronValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := Cron()
+		assert.NotNil(t, v)
+		assert.Equal(t, "cron", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+	})
+
+	cases := []struct {
+		value any
+		want  bool
+	}{
+		{value: "*/5 * * * *", want: true},
+		{value: "0 0 1 1 *", want: true},
+		{value: "* * * * *", want: true},
+		{value: "59 23 31 12 7", want: true},
+		{value: "0 9-17 * * 1-5", want: true},
+		{value: "0-30/10 */2 1,15 * *", want: true},
+		{value: "5/15 * * * *", want: true},
+		{value: "0 0 * jan,Jul MON-fri", want: true},
+		{value: "  0  0  *  *  SUN ", want: true},
+		{value: "30 */5 * * * *", want: true},
+		{value: "60 * * * *", want: false},
+		{value: "* 24 * * *", want: false},
+		{value: "* * 0 * *", want: false},
+		{value: "* * 32 * *", want: false},
+		{value: "* * * 13 *", want: false},
+		{value: "* * * * 8", want: false},
+		{value: "60 * * * * *", want: false},
+		{value: "5-1 * * * *", want: false},
+		{value: "*/0 * * * *", want: false},
+		{value: "*/ * * * *", want: false},
+		{value: "*/60 * * * *", want: false},
+		{value: "1,,2 * * * *", want: false},
+		{value: "-1 * * * *", want: false},
+		{value: "+1 * * * *", want: false},
+		{value: "a * * * *", want: false},
+		{value: "* * * FOO *", want: false},
+		{value: "* * * * JAN", want: false},
+		{value: "* * * *", want: false},
+		{value: "* * * * * * *", want: false},
+		{value: "", want: false},
+		{value: 5, want: false},
+		{value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%t", c.value, c.want), func(t *testing.T) {
+			v := Cron()
+			assert.Equal(t, c.want, v.Validate(&Context{Value: c.value}))
+		})
+	}
+}
@@ -84,6 +84,10 @@ func (v *DateComparisonValidator) MessagePlaceholders(_ *Context) []string {
 //------------------------------
 
 // DateFieldComparisonValidator factorized date comparison validator for field dates (before field, after field, etc.)
+//
+// If the path contains arrays or wildcards, the date under validation is compared with every
+// matched date. If an array (or an object matched by a wildcard) is empty, no date is matched and
+// the validator passes. Missing fields (including the arrays themselves) don't pass.
 type DateFieldComparisonValidator struct {
 	Path *walk.Path
 	BaseValidator
@@ -97,8 +101,7 @@ func (v *DateFieldComparisonValidator) validate(ctx *Context, comparisonFunc fun
 
 	ok = true
 	v.Path.Walk(ctx.Data, func(c *walk.Context) {
-		lastParent := c.Path.LastParent()
-		if lastParent != nil && lastParent.Type == walk.PathTypeArray && c.Found == walk.ElementNotFound {
+		if isEmptyCollection(c) {
 			return
 		}
 
@@ -168,9 +171,19 @@ func (v *DateEqualsFieldValidator) Name() string { return "date_equals" }
 // DateEqualsField the field under validation must be a date (`time.Time`) equal to the date field identified
 // by the given path.
 func DateEqualsField(path string) *DateEqualsFieldValidator {
+	v, err := DateEqualsFieldE(path)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// DateEqualsFieldE is the same as `DateEqualsField()` but returns an error instead of panicking
+// if the given path cannot be parsed.
+func DateEqualsFieldE(path string) (*DateEqualsFieldValidator, error) {
 	p, err := walk.Parse(path)
 	if err != nil {
-		panic(errors.NewSkip(fmt.Errorf("validation.DateEqualsField: path parse error: %w", err), 3))
+		return nil, fmt.Errorf("validation.DateEqualsField: path parse error: %w", err)
 	}
-	return &DateEqualsFieldValidator{DateFieldComparisonValidator: DateFieldComparisonValidator{Path: p}}
+	return &DateEqualsFieldValidator{DateFieldComparisonValidator: DateFieldComparisonValidator{Path: p}}, nil
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"goyave.dev/goyave/v5/lang"
 )
 
@@ -119,6 +120,13 @@ func TestEqualsFieldValidator(t *testing.T) {
 		assert.Panics(t, func() {
 			DateEqualsField("invalid[path.")
 		})
+
+		v2, err := DateEqualsFieldE(path)
+		require.NoError(t, err)
+		assert.Equal(t, path, v2.Path.String())
+		v2, err = DateEqualsFieldE("invalid[path.")
+		require.Error(t, err)
+		assert.Nil(t, v2)
 	})
 
 	ref1 := lo.Must(time.Parse(time.RFC3339, "2023-03-15T10:07:42Z"))
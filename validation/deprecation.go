@@ -0,0 +1,69 @@
+package validation
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"goyave.dev/goyave/v5/slog"
+)
+
+var (
+	// deprecatedValidators associates the name of the deprecated validators with their replacement.
+	deprecatedValidators sync.Map
+	// warnedDeprecations contains the name of the deprecated validators already reported.
+	warnedDeprecations sync.Map
+	// pendingDeprecations the number of deprecated validators not reported yet.
+	// The rules are not scanned if it is zero.
+	pendingDeprecations atomic.Int64
+)
+
+// DeprecateRule marks the validators with the given name (as returned by `Validator.Name()`)
+// as deprecated. The rule sets using them keep working, but the first time such a validator
+// is found in the rules given to `Validate()`, a warning pointing to the given replacement
+// is logged using `Options.Logger`. The warning is only logged once for the whole
+// lifetime of the program, not for every request.
+//
+// Once all the deprecated validators have been reported, the rules are not scanned anymore
+// so there is no overhead for the following validations.
+//
+// The replacement can be empty if there is none.
+func DeprecateRule(name, replacement string) {
+	_, existed := deprecatedValidators.Swap(name, replacement)
+	_, warned := warnedDeprecations.LoadAndDelete(name)
+	if !existed || warned {
+		pendingDeprecations.Add(1)
+	}
+}
+
+// warnDeprecatedRules logs a warning for each deprecated validator used in the given
+// rules that hasn't been reported yet.
+func warnDeprecatedRules(rules Rules, logger *slog.Logger) {
+	if pendingDeprecations.Load() <= 0 {
+		return
+	}
+	for _, field := range rules {
+		warnDeprecatedField(field, logger)
+	}
+}
+
+func warnDeprecatedField(field *Field, logger *slog.Logger) {
+	for _, v := range field.Validators {
+		name := v.Name()
+		replacement, ok := deprecatedValidators.Load(name)
+		if !ok {
+			continue
+		}
+		if _, warned := warnedDeprecations.LoadOrStore(name, struct{}{}); warned {
+			continue
+		}
+		pendingDeprecations.Add(-1)
+		if replacement == "" {
+			logger.Warn("validation: deprecated validator", "validator", name)
+		} else {
+			logger.Warn("validation: deprecated validator", "validator", name, "replacement", replacement)
+		}
+	}
+	if field.Elements != nil {
+		warnDeprecatedField(field.Elements, logger)
+	}
+}
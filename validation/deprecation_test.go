@@ -0,0 +1,66 @@
+package validation
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goyave.dev/goyave/v5/slog"
+)
+
+func TestDeprecateRule(t *testing.T) {
+	t.Cleanup(func() {
+		if _, warned := warnedDeprecations.LoadAndDelete("string"); !warned {
+			pendingDeprecations.Add(-1)
+		}
+		deprecatedValidators.Delete("string")
+	})
+
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewHandler(false, buf))
+
+	DeprecateRule("string", "text")
+
+	validate := func() {
+		_, errs := Validate(&Options{
+			Data: map[string]any{"field": "a", "array": []any{"b"}},
+			Rules: RuleSet{
+				{Path: "field", Rules: List{Required(), String()}},
+				{Path: "array", Rules: List{Required(), Array()}},
+				{Path: "array[]", Rules: List{String()}},
+			},
+			Logger: logger,
+		})
+		require.Empty(t, errs)
+	}
+
+	assert.Equal(t, int64(1), pendingDeprecations.Load())
+	validate()
+	assert.Equal(t, int64(0), pendingDeprecations.Load()) // Next validations don't scan the rules
+	validate()
+
+	output := buf.String()
+	assert.Equal(t, 1, strings.Count(output, "deprecated validator"))
+	assert.Contains(t, output, `"validator":"string"`)
+	assert.Contains(t, output, `"replacement":"text"`)
+
+	t.Run("deprecate_again", func(t *testing.T) {
+		// The warning is logged again with the new replacement
+		DeprecateRule("string", "text2")
+		assert.Equal(t, int64(1), pendingDeprecations.Load())
+		validate()
+		assert.Equal(t, int64(0), pendingDeprecations.Load())
+		assert.Equal(t, 2, strings.Count(buf.String(), "deprecated validator"))
+		assert.Contains(t, buf.String(), `"replacement":"text2"`)
+	})
+
+	t.Run("no_logger", func(t *testing.T) {
+		_, errs := Validate(&Options{
+			Data:  map[string]any{"field": "a"},
+			Rules: RuleSet{{Path: "field", Rules: List{String()}}},
+		})
+		assert.Empty(t, errs)
+	})
+}
@@ -13,6 +13,10 @@ import (
 // For arrays, objects and numbers, the values are compared using `reflect.DeepEqual()`.
 // For numbers, make sure the two compared numbers have the same type. A `uint` with value `1` will be considered
 // different from an `int` with value `1`.
+//
+// If the path contains arrays or wildcards, the field under validation must be different from every
+// matched element. If an array (or an object matched by a wildcard) is empty, no element is
+// matched and the validator passes. Missing fields (including the arrays themselves) don't pass.
 type DifferentValidator struct {
 	Path *walk.Path
 	BaseValidator
@@ -29,8 +33,7 @@ func (v *DifferentValidator) Validate(ctx *Context) bool {
 	}
 
 	v.Path.Walk(ctx.Data, func(c *walk.Context) {
-		lastParent := c.Path.LastParent()
-		if lastParent != nil && lastParent.Type == walk.PathTypeArray && c.Found == walk.ElementNotFound {
+		if isEmptyCollection(c) {
 			return
 		}
 
@@ -74,10 +77,24 @@ func (v *DifferentValidator) MessagePlaceholders(_ *Context) []string {
 // For arrays, objects and numbers, the values are compared using `reflect.DeepEqual()`.
 // For numbers, make sure the two compared numbers have the same type. A `uint` with value `1` will be considered
 // different from an `int` with value `1`.
+//
+// If the path contains arrays or wildcards, the field under validation must be different from every
+// matched element. If an array (or an object matched by a wildcard) is empty, no element is
+// matched and the validator passes. Missing fields (including the arrays themselves) don't pass.
 func Different(path string) *DifferentValidator {
+	v, err := DifferentE(path)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// DifferentE is the same as `Different()` but returns an error instead of panicking
+// if the given path cannot be parsed.
+func DifferentE(path string) (*DifferentValidator, error) {
 	p, err := walk.Parse(path)
 	if err != nil {
-		panic(errors.NewSkip(fmt.Errorf("validation.Different: path parse error: %w", err), 3))
+		return nil, fmt.Errorf("validation.Different: path parse error: %w", err)
 	}
-	return &DifferentValidator{Path: p}
+	return &DifferentValidator{Path: p}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"goyave.dev/goyave/v5/lang"
 )
 
@@ -21,6 +22,13 @@ func TestDifferentValidator(t *testing.T) {
 		assert.Panics(t, func() {
 			Different("invalid[path.")
 		})
+
+		v2, err := DifferentE(path)
+		require.NoError(t, err)
+		assert.Equal(t, path, v2.Path.String())
+		v2, err = DifferentE("invalid[path.")
+		require.Error(t, err)
+		assert.Nil(t, v2)
 	})
 
 	cases := []struct {
@@ -1,5 +1,7 @@
 package validation
 
+import "reflect"
+
 // DistinctValidator validates the field under validation must be an array having
 // distinct values.
 type DistinctValidator[T comparable] struct {
@@ -35,3 +37,55 @@ func (v *DistinctValidator[T]) Name() string { return "distinct" }
 func Distinct[T comparable]() *DistinctValidator[T] {
 	return &DistinctValidator[T]{}
 }
+
+//------------------------------
+
+// DistinctByValidator validates the field under validation must be an array of objects
+// having distinct values for the given property.
+type DistinctByValidator struct {
+	BaseValidator
+	Property string
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *DistinctByValidator) Validate(ctx *Context) bool {
+	list := reflect.ValueOf(ctx.Value)
+	if list.Kind() != reflect.Slice {
+		return false
+	}
+
+	found := make(map[any]struct{}, list.Len())
+	for i := range list.Len() {
+		object, ok := list.Index(i).Interface().(map[string]any)
+		if !ok {
+			ctx.AddArrayElementValidationErrors(i)
+			return true
+		}
+		value, ok := object[v.Property]
+		if !ok || (value != nil && !reflect.TypeOf(value).Comparable()) {
+			ctx.AddArrayElementValidationErrors(i)
+			return true
+		}
+		if _, ok := found[value]; ok {
+			ctx.AddArrayElementValidationErrors(i)
+			return true
+		}
+		found[value] = struct{}{}
+	}
+	return true
+}
+
+// Name returns the string name of the validator.
+func (v *DistinctByValidator) Name() string { return "distinct" }
+
+// DistinctBy the field under validation must be an array of objects having distinct
+// values for the given property. The values are compared using the `==` operator, so
+// values of different types are always considered distinct (e.g. `int(1)` and `float64(1)`).
+//
+// The first element that is not an object, doesn't have the property, has a non-comparable
+// value (such as an object or an array) for the property, or duplicates a previous element's value
+// is marked as invalid (see `Context.AddArrayElementValidationErrors`).
+// Values that are not arrays don't pass.
+func DistinctBy(property string) *DistinctByValidator {
+	return &DistinctByValidator{Property: property}
+}
@@ -49,3 +49,44 @@ func TestDistinctValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestDistinctByValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := DistinctBy("id")
+		assert.NotNil(t, v)
+		assert.Equal(t, "distinct", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+		assert.Equal(t, "id", v.Property)
+	})
+
+	cases := []struct {
+		value             any
+		desc              string
+		wantElementErrors []int
+		want              bool
+	}{
+		{desc: "unique", value: []map[string]any{{"id": 1}, {"id": 2}, {"id": 3}}, want: true},
+		{desc: "unique_any", value: []any{map[string]any{"id": "a"}, map[string]any{"id": "b"}}, want: true},
+		{desc: "empty", value: []any{}, want: true},
+		{desc: "different_types", value: []map[string]any{{"id": 1}, {"id": 1.0}}, want: true},
+		{desc: "nil_values", value: []map[string]any{{"id": nil}, {"id": 1}}, want: true},
+		{desc: "duplicate", value: []map[string]any{{"id": 1}, {"id": 2}, {"id": 1}, {"id": 2}}, want: true, wantElementErrors: []int{2}},
+		{desc: "duplicate_nil", value: []map[string]any{{"id": nil}, {"id": nil}}, want: true, wantElementErrors: []int{1}},
+		{desc: "missing_property", value: []map[string]any{{"id": 1}, {"name": "a"}}, want: true, wantElementErrors: []int{1}},
+		{desc: "not_an_object", value: []any{map[string]any{"id": 1}, 2}, want: true, wantElementErrors: []int{1}},
+		{desc: "not_comparable", value: []map[string]any{{"id": []int{1}}}, want: true, wantElementErrors: []int{0}},
+		{desc: "not_an_array", value: map[string]any{"id": 1}, want: false},
+		{desc: "nil", value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%s_%t", c.desc, c.want), func(t *testing.T) {
+			v := DistinctBy("id")
+			ctx := &Context{Value: c.value}
+			assert.Equal(t, c.want, v.Validate(ctx))
+			assert.Equal(t, c.wantElementErrors, ctx.ArrayElementErrors())
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package validation
+
+import "regexp"
+
+// dnsLabelRegex RFC 1123 DNS label: alphanumeric characters and hyphens,
+// not starting or ending with a hyphen. The length is checked separately.
+var dnsLabelRegex = regexp.MustCompile(`^[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?$`)
+
+var (
+	// dns1123SubdomainRegex lowercase RFC 1123 subdomain: dot-separated lowercase DNS labels.
+	dns1123SubdomainRegex = regexp.MustCompile(`^[a-z0-9](?:[a-z0-9-]*[a-z0-9])?(?:\.[a-z0-9](?:[a-z0-9-]*[a-z0-9])?)*$`)
+
+	// dns1035LabelRegex lowercase RFC 1035 label: same as a RFC 1123 label but must start with a letter.
+	dns1035LabelRegex = regexp.MustCompile(`^[a-z](?:[a-z0-9-]*[a-z0-9])?$`)
+)
+
+// DNSLabelValidator validates the field under validation must be a string
+// representing a single DNS label as defined by RFC 1123: between 1 and 63 characters
+// long, only containing alphanumeric characters and hyphens, not starting
+// nor ending with a hyphen.
+type DNSLabelValidator struct{ BaseValidator }
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *DNSLabelValidator) Validate(ctx *Context) bool {
+	str, ok := ctx.Value.(string)
+	return ok && len(str) <= 63 && dnsLabelRegex.MatchString(str)
+}
+
+// Name returns the string name of the validator.
+func (v *DNSLabelValidator) Name() string { return "dns_label" }
+
+// DNSLabel the field under validation must be a string representing a single DNS label
+// as defined by RFC 1123: between 1 and 63 characters long, only containing alphanumeric
+// characters and hyphens, not starting nor ending with a hyphen. This is useful to validate
+// a subdomain chosen by a user. Dots are not accepted: the value cannot be a full domain name.
+func DNSLabel() *DNSLabelValidator {
+	return &DNSLabelValidator{}
+}
+
+//------------------------------
+
+// ResourceNameValidator validates the field under validation must be a string
+// representing a resource name as used by Kubernetes objects: a lowercase RFC 1123
+// subdomain (lowercase alphanumeric characters, "-" and ".", at most 253 characters long).
+//
+// If `Strict` is true, the name must be a lowercase RFC 1035 label instead: lowercase
+// alphanumeric characters and "-", starting with a letter, at most 63 characters long.
+type ResourceNameValidator struct {
+	BaseValidator
+	Strict bool
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *ResourceNameValidator) Validate(ctx *Context) bool {
+	str, ok := ctx.Value.(string)
+	if !ok {
+		return false
+	}
+	if v.Strict {
+		return len(str) <= 63 && dns1035LabelRegex.MatchString(str)
+	}
+	return len(str) <= 253 && dns1123SubdomainRegex.MatchString(str)
+}
+
+// Name returns the string name of the validator.
+func (v *ResourceNameValidator) Name() string { return "resource_name" }
+
+// ResourceName the field under validation must be a string representing a resource name
+// as used by Kubernetes objects: a lowercase RFC 1123 subdomain (lowercase alphanumeric
+// characters, "-" and ".", at most 253 characters long, each dot-separated part starting
+// and ending with an alphanumeric character).
+//
+// Set the `Strict` field of the returned validator to true to require a lowercase
+// RFC 1035 label instead (e.g. for Kubernetes services names): lowercase alphanumeric
+// characters and "-", starting with a letter, at most 63 characters long.
+func ResourceName() *ResourceNameValidator {
+	return &ResourceNameValidator{}
+}
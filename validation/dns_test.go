@@ -0,0 +1,102 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDNSLabelValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := DNSLabel()
+		assert.NotNil(t, v)
+		assert.Equal(t, "dns_label", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+	})
+
+	cases := []struct {
+		value any
+		want  bool
+	}{
+		{value: "my-shop", want: true},
+		{value: "a", want: true},
+		{value: "1", want: true},
+		{value: "Shop42", want: true},
+		{value: "123-abc", want: true},
+		{value: strings.Repeat("a", 63), want: true},
+		{value: strings.Repeat("a", 64), want: false},
+		{value: "-shop", want: false},
+		{value: "shop-", want: false},
+		{value: "my_shop", want: false},
+		{value: "my.shop", want: false},
+		{value: "my shop", want: false},
+		{value: "bouée", want: false},
+		{value: "", want: false},
+		{value: 1, want: false},
+		{value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%t", c.value, c.want), func(t *testing.T) {
+			v := DNSLabel()
+			assert.Equal(t, c.want, v.Validate(&Context{Value: c.value}))
+		})
+	}
+}
+
+func TestResourceNameValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := ResourceName()
+		assert.NotNil(t, v)
+		assert.Equal(t, "resource_name", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+		assert.False(t, v.Strict)
+	})
+
+	label := strings.Repeat("a", 63)
+	cases := []struct {
+		value  any
+		strict bool
+		want   bool
+	}{
+		{value: "my-app", want: true},
+		{value: "my-app.v1", want: true},
+		{value: "1-app", want: true},
+		{value: "a", want: true},
+		{value: strings.Join([]string{label, label, label, strings.Repeat("a", 61)}, "."), want: true}, // 253 characters
+		{value: strings.Join([]string{label, label, label, strings.Repeat("a", 62)}, "."), want: false},
+		{value: "My-App", want: false},
+		{value: "my_app", want: false},
+		{value: "-my-app", want: false},
+		{value: "my-app-", want: false},
+		{value: "my..app", want: false},
+		{value: ".my-app", want: false},
+		{value: "my-app.", want: false},
+		{value: "", want: false},
+		{value: "my-app", strict: true, want: true},
+		{value: "a1", strict: true, want: true},
+		{value: label, strict: true, want: true},
+		{value: label + "a", strict: true, want: false},
+		{value: "1-app", strict: true, want: false},
+		{value: "my-app.v1", strict: true, want: false},
+		{value: "My-App", strict: true, want: false},
+		{value: "my-app-", strict: true, want: false},
+		{value: "", strict: true, want: false},
+		{value: 1, want: false},
+		{value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%t_%t", c.value, c.strict, c.want), func(t *testing.T) {
+			v := ResourceName()
+			v.Strict = c.strict
+			assert.Equal(t, c.want, v.Validate(&Context{Value: c.value}))
+		})
+	}
+}
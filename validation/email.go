@@ -1,6 +1,25 @@
 package validation
 
-import "net/mail"
+import (
+	"context"
+	"errors"
+	"net"
+	"net/mail"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Email validation modes. See `EmailValidator`.
+const (
+	EmailModeRFC5322 = "rfc5322"
+	EmailModeSimple  = "simple"
+)
+
+// simpleEmailRegex the email address format used by the HTML "email" input type.
+// See https://html.spec.whatwg.org/multipage/input.html#valid-e-mail-address
+var simpleEmailRegex = regexp.MustCompile(`^[a-zA-Z0-9.!#$%&'*+/=?^_\x60{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`)
 
 // EmailValidator the field under validation must be a string that can be parsed
 // using Go's standard `mail.ParseAddress` function.
@@ -9,12 +28,17 @@ import "net/mail"
 //   - Barry Gibbs <bg@example.com>
 //   - foo@example.com
 //
+// If `Mode` is `EmailModeSimple`, the address must also match the practical subset used by
+// the HTML "email" input type: display names, quoted local parts (e.g. `"a b"@example.com`)
+// and comments are rejected. The default mode is `EmailModeRFC5322`.
+//
 // This validator is not enough in itself to properly validate an email address.
 // The only way to ensure an email address is valid is by sending a confirmation email.
 //
 // On successful validation, converts the value to `string`.
 type EmailValidator struct {
 	BaseValidator
+	Mode string
 }
 
 // Validate checks the field under validation satisfies this validator's criteria.
@@ -29,10 +53,14 @@ func (v *EmailValidator) Validate(ctx *Context) bool {
 	}
 
 	addr, err := mail.ParseAddress(val)
-	if err == nil {
-		ctx.Value = addr.Address
+	if err != nil {
+		return false
+	}
+	if v.Mode == EmailModeSimple && !simpleEmailRegex.MatchString(val) {
+		return false
 	}
-	return err == nil
+	ctx.Value = addr.Address
+	return true
 }
 
 // IsType returns true.
@@ -50,10 +78,159 @@ func (v *EmailValidator) Name() string { return "email" }
 //   - Barry Gibbs <bg@example.com>
 //   - foo@example.com
 //
+// Set the `Mode` field of the returned validator to `EmailModeSimple` to only accept
+// the practical subset used by the HTML "email" input type: display names,
+// quoted local parts (e.g. `"a b"@example.com`) and comments are rejected.
+//
 // This validator is not enough in itself to properly validate an email address.
 // The only way to ensure an email address is valid is by sending a confirmation email.
 //
 // On successful validation, converts the value to `string`.
 func Email() *EmailValidator {
-	return &EmailValidator{}
+	return &EmailValidator{Mode: EmailModeRFC5322}
+}
+
+//------------------------------
+
+// MXResolver looks up the MX records of a domain. `*net.Resolver` implements this interface.
+type MXResolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// maxMXCacheEntries the maximum number of domains kept in a `MXCache`.
+const maxMXCacheEntries = 10000
+
+// MXCache caches the result of MX lookups by domain name for a fixed duration.
+// To keep its memory usage bounded, it cannot contain more than 10000 domains:
+// when full, the expired entries are removed, or all of them if none expired.
+// It is safe for concurrent use.
+type MXCache struct {
+	entries map[string]mxCacheEntry
+	ttl     time.Duration
+	mu      sync.Mutex
+}
+
+type mxCacheEntry struct {
+	expiresAt time.Time
+	ok        bool
+}
+
+// NewMXCache create a new `MXCache` keeping the results for the given duration.
+func NewMXCache(ttl time.Duration) *MXCache {
+	return &MXCache{
+		entries: map[string]mxCacheEntry{},
+		ttl:     ttl,
+	}
+}
+
+func (c *MXCache) get(domain string, now time.Time) (ok bool, found bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, found := c.entries[domain]
+	if !found {
+		return false, false
+	}
+	if now.After(entry.expiresAt) {
+		delete(c.entries, domain)
+		return false, false
+	}
+	return entry.ok, true
+}
+
+func (c *MXCache) put(domain string, ok bool, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= maxMXCacheEntries {
+		for d, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, d)
+			}
+		}
+		if len(c.entries) >= maxMXCacheEntries {
+			clear(c.entries)
+		}
+	}
+	c.entries[domain] = mxCacheEntry{ok: ok, expiresAt: now.Add(c.ttl)}
+}
+
+// DefaultMXCache the cache used by `EmailMX()` validators. Results are kept for 10 minutes.
+var DefaultMXCache = NewMXCache(10 * time.Minute)
+
+// EmailMXValidator the field under validation must be a valid email address
+// (see `EmailValidator`) whose domain has at least one MX record.
+//
+// The DNS lookup is only performed if `Resolver` is not nil. The lookup is bounded
+// by `Timeout` (`DefaultResolveTimeout` if not set) and its result is stored
+// in `Cache` (if not nil). Temporary DNS errors (such as timeouts) fail the validation
+// but are not cached.
+//
+// On successful validation, converts the value to `string`.
+type EmailMXValidator struct {
+	EmailValidator
+	Resolver MXResolver
+	Cache    *MXCache
+	Timeout  time.Duration
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *EmailMXValidator) Validate(ctx *Context) bool {
+	if !v.EmailValidator.Validate(ctx) {
+		return false
+	}
+	if v.Resolver == nil {
+		return true
+	}
+	address := ctx.Value.(string)
+	domain := strings.ToLower(address[strings.LastIndexByte(address, '@')+1:])
+	now := time.Now()
+	if v.Cache != nil {
+		if ok, found := v.Cache.get(domain, now); found {
+			return ok
+		}
+	}
+
+	ok, temporary := v.lookup(ctx.Context, domain)
+	if v.Cache != nil && !temporary {
+		v.Cache.put(domain, ok, now)
+	}
+	return ok
+}
+
+func (v *EmailMXValidator) lookup(ctx context.Context, domain string) (ok bool, temporary bool) {
+	timeout := v.Timeout
+	if timeout <= 0 {
+		timeout = DefaultResolveTimeout
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	records, err := v.Resolver.LookupMX(ctx, domain)
+	if err != nil {
+		var dnsErr *net.DNSError
+		return false, !errors.As(err, &dnsErr) || !dnsErr.IsNotFound
+	}
+	for _, mx := range records {
+		// A single "." record is a "null MX" (RFC 7505): the domain doesn't accept email.
+		if mx.Host != "." && mx.Host != "" {
+			return true, false
+		}
+	}
+	return false, false
+}
+
+// EmailMX the field under validation must be a valid email address (see `Email()`)
+// whose domain has at least one MX record.
+//
+// To avoid network calls by default (in tests for example), the DNS lookup is
+// disabled until the `Resolver` field of the returned validator is set (e.g. to `net.DefaultResolver`).
+// The lookup is bounded by the `Timeout` field (`DefaultResolveTimeout` if not set)
+// and the results are cached in `DefaultMXCache`. Set the `Cache` field to
+// use another cache, or to `nil` to disable caching.
+//
+// On successful validation, converts the value to `string`.
+func EmailMX() *EmailMXValidator {
+	return &EmailMXValidator{EmailValidator: EmailValidator{Mode: EmailModeRFC5322}, Cache: DefaultMXCache}
 }
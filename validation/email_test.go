@@ -1,9 +1,12 @@
 package validation
 
 import (
+	"context"
 	"fmt"
+	"net"
 	"net/mail"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -15,6 +18,7 @@ func TestEmailValidator(t *testing.T) {
 		assert.Equal(t, "email", v.Name())
 		assert.True(t, v.IsType())
 		assert.False(t, v.IsTypeDependent())
+		assert.Equal(t, EmailModeRFC5322, v.Mode)
 	})
 
 	cases := []struct {
@@ -53,4 +57,177 @@ func TestEmailValidator(t *testing.T) {
 			}
 		})
 	}
+
+	modeCases := []struct {
+		value      string
+		wantValue  string
+		wantRFC    bool
+		wantSimple bool
+	}{
+		{value: "johndoe@example.org", wantRFC: true, wantSimple: true, wantValue: "johndoe@example.org"},
+		{value: "john.doe+ext@sub.example.org", wantRFC: true, wantSimple: true, wantValue: "john.doe+ext@sub.example.org"},
+		{value: "a@b", wantRFC: true, wantSimple: true, wantValue: "a@b"},
+		{value: `"a b"@example.com`, wantRFC: true, wantSimple: false, wantValue: `a b@example.com`},
+		{value: `"john..doe"@example.com`, wantRFC: true, wantSimple: false, wantValue: `john..doe@example.com`},
+		{value: "Barry Gibbs <bg@example.com>", wantRFC: true, wantSimple: false, wantValue: "bg@example.com"},
+		{value: "<bg@example.com>", wantRFC: true, wantSimple: false, wantValue: "bg@example.com"},
+		{value: "bg@example.com (comment)", wantRFC: true, wantSimple: false, wantValue: "bg@example.com"},
+		{value: "a@-example.com", wantRFC: true, wantSimple: false, wantValue: "a@-example.com"},
+		{value: "john..doe@example.com", wantRFC: false, wantSimple: false},
+		{value: "a b@example.com", wantRFC: false, wantSimple: false},
+	}
+
+	for _, c := range modeCases {
+		t.Run(fmt.Sprintf("Mode_%s", c.value), func(t *testing.T) {
+			v := Email()
+			ctx := &Context{Value: c.value}
+			ok := v.Validate(ctx)
+			if assert.Equal(t, c.wantRFC, ok, EmailModeRFC5322) && ok {
+				assert.Equal(t, c.wantValue, ctx.Value)
+			}
+
+			v.Mode = EmailModeSimple
+			ctx = &Context{Value: c.value}
+			ok = v.Validate(ctx)
+			if assert.Equal(t, c.wantSimple, ok, EmailModeSimple) && ok {
+				assert.Equal(t, c.wantValue, ctx.Value)
+			}
+		})
+	}
+}
+
+type testMXResolver struct {
+	records map[string][]*net.MX
+	delay   time.Duration
+	calls   int
+}
+
+func (r *testMXResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	r.calls++
+	select {
+	case <-ctx.Done():
+		return nil, &net.DNSError{Err: ctx.Err().Error(), Name: name, IsTimeout: true}
+	case <-time.After(r.delay):
+	}
+	records, ok := r.records[name]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	return records, nil
+}
+
+func TestEmailMXValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := EmailMX()
+		assert.NotNil(t, v)
+		assert.Equal(t, "email", v.Name())
+		assert.True(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Nil(t, v.Resolver)
+		assert.Same(t, DefaultMXCache, v.Cache)
+	})
+
+	newResolver := func() *testMXResolver {
+		return &testMXResolver{
+			records: map[string][]*net.MX{
+				"example.org": {{Host: "mx1.example.org.", Pref: 10}},
+				"nomail.org":  {},
+				"nullmx.org":  {{Host: ".", Pref: 0}},
+			},
+		}
+	}
+
+	cases := []struct {
+		value     any
+		wantValue string
+		want      bool
+	}{
+		{value: "johndoe@example.org", want: true, wantValue: "johndoe@example.org"},
+		{value: "John Doe <johndoe@EXAMPLE.org>", want: true, wantValue: "johndoe@EXAMPLE.org"},
+		{value: &mail.Address{Address: "johndoe@example.org"}, want: true, wantValue: "johndoe@example.org"},
+		{value: "johndoe@nomail.org", want: false},
+		{value: "johndoe@nullmx.org", want: false},
+		{value: "johndoe@unknown.org", want: false},
+		{value: "johndoe", want: false},
+		{value: 1, want: false},
+		{value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%t", c.value, c.want), func(t *testing.T) {
+			v := EmailMX()
+			v.Resolver = newResolver()
+			v.Cache = nil
+			ctx := &Context{
+				Context: context.Background(),
+				Value:   c.value,
+			}
+			ok := v.Validate(ctx)
+			if assert.Equal(t, c.want, ok) && ok {
+				assert.Equal(t, c.wantValue, ctx.Value)
+			}
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		v := EmailMX()
+		assert.True(t, v.Validate(&Context{Value: "johndoe@unknown.org"}))
+	})
+
+	t.Run("cache", func(t *testing.T) {
+		resolver := newResolver()
+		v := EmailMX()
+		v.Resolver = resolver
+		v.Cache = NewMXCache(time.Minute)
+
+		assert.True(t, v.Validate(&Context{Value: "a@example.org"}))
+		assert.True(t, v.Validate(&Context{Value: "b@Example.org"}))
+		assert.False(t, v.Validate(&Context{Value: "a@unknown.org"}))
+		assert.False(t, v.Validate(&Context{Value: "b@unknown.org"}))
+		assert.Equal(t, 2, resolver.calls)
+
+		v.Cache = NewMXCache(-time.Second) // Always expired
+		assert.True(t, v.Validate(&Context{Value: "a@example.org"}))
+		assert.True(t, v.Validate(&Context{Value: "a@example.org"}))
+		assert.Equal(t, 4, resolver.calls)
+	})
+
+	t.Run("cache_limit", func(t *testing.T) {
+		cache := NewMXCache(time.Minute)
+		now := time.Now()
+		for i := range maxMXCacheEntries {
+			cache.put(fmt.Sprintf("%d.org", i), true, now)
+		}
+		cache.put("expired.org", true, now.Add(-2*time.Minute)) // Evicted everything
+		assert.Len(t, cache.entries, 1)
+		cache.entries["a.org"] = mxCacheEntry{ok: true, expiresAt: now.Add(time.Minute)}
+		for i := range maxMXCacheEntries - 2 {
+			cache.put(fmt.Sprintf("%d.org", i), true, now)
+		}
+		cache.put("new.org", true, now) // Only the expired entry is evicted
+		assert.Len(t, cache.entries, maxMXCacheEntries)
+		_, found := cache.get("expired.org", now)
+		assert.False(t, found)
+		ok, found := cache.get("a.org", now)
+		assert.True(t, found)
+		assert.True(t, ok)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		resolver := newResolver()
+		resolver.delay = time.Second
+		v := EmailMX()
+		v.Resolver = resolver
+		v.Cache = NewMXCache(time.Minute)
+		v.Timeout = 10 * time.Millisecond
+
+		start := time.Now()
+		assert.False(t, v.Validate(&Context{Context: context.Background(), Value: "a@example.org"}))
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+
+		// Temporary errors are not cached
+		resolver.delay = 0
+		assert.True(t, v.Validate(&Context{Context: context.Background(), Value: "a@example.org"}))
+		assert.Equal(t, 2, resolver.calls)
+	})
 }
@@ -0,0 +1,48 @@
+package validation
+
+import "strconv"
+
+// EqualsValidator validates the field under validation must be equal to the given value.
+//
+// By default, the field under validation must be a string strictly equal to `Value`.
+// If `Numeric` is true, the field under validation must be a number equal to `Value`
+// parsed as a `float64`: `Equals("2")` with `Numeric` accepts `2` and `2.0` but not `"2"`.
+type EqualsValidator struct {
+	BaseValidator
+	Value   string
+	Numeric bool
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *EqualsValidator) Validate(ctx *Context) bool {
+	if !v.Numeric {
+		str, ok := ctx.Value.(string)
+		return ok && str == v.Value
+	}
+
+	expected, err := strconv.ParseFloat(v.Value, 64)
+	if err != nil {
+		return false
+	}
+	n, ok, err := numberAsFloat64(ctx.Value)
+	return ok && err == nil && n == expected
+}
+
+// Name returns the string name of the validator.
+func (v *EqualsValidator) Name() string { return "equals" }
+
+// MessagePlaceholders returns the ":value" placeholder.
+func (v *EqualsValidator) MessagePlaceholders(_ *Context) []string {
+	return []string{
+		":value", v.Value,
+	}
+}
+
+// Equals the field under validation must be a string strictly equal to the given value.
+// This is useful to enforce constant values, such as an API version.
+//
+// Set the `Numeric` field of the returned validator to true to compare numbers instead:
+// the field under validation must then be a number equal to the given value parsed as a `float64`.
+func Equals(value string) *EqualsValidator {
+	return &EqualsValidator{Value: value}
+}
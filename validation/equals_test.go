@@ -0,0 +1,69 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goyave.dev/goyave/v5/lang"
+)
+
+func TestEqualsValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := Equals("v2")
+		assert.NotNil(t, v)
+		assert.Equal(t, "equals", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Equal(t, []string{":value", "v2"}, v.MessagePlaceholders(&Context{}))
+		assert.Equal(t, "v2", v.Value)
+		assert.False(t, v.Numeric)
+	})
+
+	cases := []struct {
+		value    any
+		expected string
+		numeric  bool
+		want     bool
+	}{
+		{value: "v2", expected: "v2", want: true},
+		{value: "", expected: "", want: true},
+		{value: "v1", expected: "v2", want: false},
+		{value: "V2", expected: "v2", want: false},
+		{value: "v2 ", expected: "v2", want: false},
+		{value: 2, expected: "2", want: false},
+		{value: []string{"v2"}, expected: "v2", want: false},
+		{value: nil, expected: "v2", want: false},
+		{value: 2, expected: "2", numeric: true, want: true},
+		{value: 2.0, expected: "2", numeric: true, want: true},
+		{value: uint8(2), expected: "2.0", numeric: true, want: true},
+		{value: 2.5, expected: "2.5", numeric: true, want: true},
+		{value: 3, expected: "2", numeric: true, want: false},
+		{value: 2.5, expected: "2", numeric: true, want: false},
+		{value: "2", expected: "2", numeric: true, want: false},
+		{value: 2, expected: "two", numeric: true, want: false},
+		{value: nil, expected: "2", numeric: true, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%s_%t_%t", c.value, c.expected, c.numeric, c.want), func(t *testing.T) {
+			v := Equals(c.expected)
+			v.Numeric = c.numeric
+			assert.Equal(t, c.want, v.Validate(&Context{Value: c.value}))
+		})
+	}
+
+	t.Run("message", func(t *testing.T) {
+		validationErrors, errs := Validate(&Options{
+			Data:     map[string]any{"version": "v1"},
+			Language: lang.New().GetDefault(),
+			Rules: RuleSet{
+				{Path: "version", Rules: List{Required(), String(), Equals("v2")}},
+			},
+		})
+		require.Empty(t, errs)
+		require.NotNil(t, validationErrors)
+		assert.Equal(t, []string{"The version must be equal to v2."}, validationErrors.Fields["version"].Errors)
+	})
+}
@@ -1,9 +1,14 @@
 package validation
 
 import (
+	"encoding/json"
+
 	"goyave.dev/goyave/v5/util/walk"
 )
 
+// DefaultEnvelopeKey the key used by `Envelope` if none is specified.
+const DefaultEnvelopeKey = "validationError"
+
 // Errors structure representing the errors associated with an element.
 // If the element is an object (`map[string]any`), `Fields` represents the
 // errors associated with this object's fields. The key is the name of the field. `Fields` may be `nil`.
@@ -55,6 +60,10 @@ func (e *Errors) Add(path *walk.Path, message string) {
 	}
 }
 
+func (e *Errors) isEmpty() bool {
+	return len(e.Errors) == 0 && len(e.Elements) == 0 && len(e.Fields) == 0
+}
+
 // Merge the given errors into this bag of errors at the given path.
 // This can be used when a validator uses nested validation and wants
 // to add the results in the higher-level validation errors.
@@ -146,3 +155,37 @@ func (e ArrayErrors) Merge(path *walk.Path, index int, errors *Errors) {
 	}
 	errs.Merge(path, errors)
 }
+
+// Envelope wraps these errors in an `Envelope` using the given key.
+// If the key is empty, `DefaultEnvelopeKey` is used.
+func (e *Errors) Envelope(key string) Envelope {
+	return Envelope{Errors: e, Key: key}
+}
+
+// Envelope wraps validation `Errors` in a JSON object containing a single key so
+// API responses have a stable shape. For example, with the default key:
+//
+//	{"validationError": {"fields": {...}}}
+//
+// `Errors` themselves are still marshaled as a plain object, so the raw
+// format remains available by marshaling the `Errors` directly.
+type Envelope struct {
+	Errors *Errors
+
+	// Key the name of the wrapping key. Defaults to `DefaultEnvelopeKey`.
+	Key string
+}
+
+// MarshalJSON implementation of `json.Marshaler`.
+// `nil` errors are marshaled as an empty object.
+func (e Envelope) MarshalJSON() ([]byte, error) {
+	key := e.Key
+	if key == "" {
+		key = DefaultEnvelopeKey
+	}
+	errs := e.Errors
+	if errs == nil {
+		errs = &Errors{}
+	}
+	return json.Marshal(map[string]*Errors{key: errs})
+}
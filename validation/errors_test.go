@@ -1,10 +1,12 @@
 package validation
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"goyave.dev/goyave/v5/util/walk"
 )
 
@@ -436,3 +438,34 @@ func TestErrors(t *testing.T) {
 		}
 	})
 }
+
+func TestErrorsEnvelope(t *testing.T) {
+	errs := &Errors{}
+	errs.Add(walk.MustParse("root.field"), "message")
+
+	cases := []struct {
+		errs *Errors
+		desc string
+		key  string
+		want string
+	}{
+		{desc: "default_key", errs: errs, key: "", want: `{"validationError":{"fields":{"field":{"errors":["message"]}}}}`},
+		{desc: "custom_key", errs: errs, key: "errors", want: `{"errors":{"fields":{"field":{"errors":["message"]}}}}`},
+		{desc: "empty", errs: &Errors{}, key: "", want: `{"validationError":{}}`},
+		{desc: "nil", errs: nil, key: "", want: `{"validationError":{}}`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			res, err := json.Marshal(c.errs.Envelope(c.key))
+			require.NoError(t, err)
+			assert.JSONEq(t, c.want, string(res))
+		})
+	}
+
+	t.Run("raw", func(t *testing.T) {
+		res, err := json.Marshal(errs)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"fields":{"field":{"errors":["message"]}}}`, string(res))
+	})
+}
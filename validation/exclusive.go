@@ -0,0 +1,209 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+	"goyave.dev/goyave/v5/util/errors"
+	"goyave.dev/goyave/v5/util/walk"
+)
+
+// MutuallyExclusiveValidator validates that at most one of the fields identified
+// by the given paths is present. A field is considered present if it exists, is not `nil` and is not
+// an empty string, an empty slice or an empty object.
+// The paths are relative to the root element.
+//
+// This validator doesn't depend on the value of the field under validation. It is meant
+// to be used on the `CurrentElement` of a rule set (or on its parent object), so it is
+// executed once for the whole rule set.
+type MutuallyExclusiveValidator struct {
+	BaseValidator
+	Paths []*walk.Path
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *MutuallyExclusiveValidator) Validate(ctx *Context) bool {
+	return countPresent(ctx.Data, v.Paths) <= 1
+}
+
+// Name returns the string name of the validator.
+func (v *MutuallyExclusiveValidator) Name() string { return "mutually_exclusive" }
+
+// MessagePlaceholders returns the ":others" placeholder.
+func (v *MutuallyExclusiveValidator) MessagePlaceholders(_ *Context) []string {
+	return []string{
+		":others", joinFieldNames(v, v.Paths),
+	}
+}
+
+// MutuallyExclusive validates that at most one of the fields identified
+// by the given paths is present. A field is considered present if it exists, is not `nil` and is not
+// an empty string, an empty slice or an empty object.
+// The paths are relative to the root element.
+//
+// This validator doesn't depend on the value of the field under validation. It is meant
+// to be used on the `CurrentElement` of a rule set (or on its parent object), so it is
+// executed once for the whole rule set.
+func MutuallyExclusive(paths ...string) *MutuallyExclusiveValidator {
+	v, err := MutuallyExclusiveE(paths...)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// MutuallyExclusiveE is the same as `MutuallyExclusive()` but returns an error instead of panicking
+// if one of the given paths cannot be parsed.
+func MutuallyExclusiveE(paths ...string) (*MutuallyExclusiveValidator, error) {
+	p, err := parsePaths("validation.MutuallyExclusive", paths)
+	if err != nil {
+		return nil, err
+	}
+	return &MutuallyExclusiveValidator{Paths: p}, nil
+}
+
+// parsePaths parses all the given paths. Returns an error if one of them cannot be parsed.
+func parsePaths(validatorName string, paths []string) ([]*walk.Path, error) {
+	parsed := make([]*walk.Path, 0, len(paths))
+	for _, path := range paths {
+		p, err := walk.Parse(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: path parse error: %w", validatorName, err)
+		}
+		parsed = append(parsed, p)
+	}
+	return parsed, nil
+}
+
+// countPresent returns the number of paths matching an element that exists, is not `nil`
+// and is not empty.
+func countPresent(data any, paths []*walk.Path) int {
+	return lo.CountBy(paths, func(p *walk.Path) bool {
+		value, ok := p.Lookup(data)
+		return ok && value != nil && !isEmpty(value)
+	})
+}
+
+// joinFieldNames returns the translated names of the fields identified by the given paths.
+func joinFieldNames(c Composable, paths []*walk.Path) string {
+	return strings.Join(lo.Map(paths, func(p *walk.Path, _ int) string {
+		return GetFieldName(c.Lang(), p)
+	}), ", ")
+}
+
+//------------------------------
+
+// RequiredTogetherValidator validates that either all the fields identified by the
+// given paths are present, or none of them. A field is considered present if it exists,
+// is not `nil` and is not an empty string, an empty slice or an empty object.
+// The paths are relative to the root element.
+//
+// Like `MutuallyExclusive()`, this validator is meant to be used on the `CurrentElement`
+// of a rule set (or on its parent object).
+type RequiredTogetherValidator struct {
+	BaseValidator
+	Paths []*walk.Path
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *RequiredTogetherValidator) Validate(ctx *Context) bool {
+	count := countPresent(ctx.Data, v.Paths)
+	return count == 0 || count == len(v.Paths)
+}
+
+// Name returns the string name of the validator.
+func (v *RequiredTogetherValidator) Name() string { return "required_together" }
+
+// MessagePlaceholders returns the ":others" placeholder.
+func (v *RequiredTogetherValidator) MessagePlaceholders(_ *Context) []string {
+	return []string{
+		":others", joinFieldNames(v, v.Paths),
+	}
+}
+
+// RequiredTogether validates that either all the fields identified by the
+// given paths are present, or none of them. A field is considered present if it exists,
+// is not `nil` and is not an empty string, an empty slice or an empty object.
+// The paths are relative to the root element.
+//
+// Like `MutuallyExclusive()`, this validator is meant to be used on the `CurrentElement`
+// of a rule set (or on its parent object).
+func RequiredTogether(paths ...string) *RequiredTogetherValidator {
+	v, err := RequiredTogetherE(paths...)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// RequiredTogetherE is the same as `RequiredTogether()` but returns an error instead of panicking
+// if one of the given paths cannot be parsed.
+func RequiredTogetherE(paths ...string) (*RequiredTogetherValidator, error) {
+	p, err := parsePaths("validation.RequiredTogether", paths)
+	if err != nil {
+		return nil, err
+	}
+	return &RequiredTogetherValidator{Paths: p}, nil
+}
+
+//------------------------------
+
+// RequiredCountValidator validates that exactly `Count` of the fields identified by the
+// given paths are present. A field is considered present if it exists, is not `nil` and
+// is not an empty string, an empty slice or an empty object.
+// The paths are relative to the root element.
+//
+// Like `MutuallyExclusive()`, this validator is meant to be used on the `CurrentElement`
+// of a rule set (or on its parent object).
+type RequiredCountValidator struct {
+	BaseValidator
+	Paths []*walk.Path
+	Count int
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *RequiredCountValidator) Validate(ctx *Context) bool {
+	return countPresent(ctx.Data, v.Paths) == v.Count
+}
+
+// Name returns the string name of the validator.
+func (v *RequiredCountValidator) Name() string { return "required_count" }
+
+// MessagePlaceholders returns the ":count" and ":others" placeholders.
+func (v *RequiredCountValidator) MessagePlaceholders(_ *Context) []string {
+	return []string{
+		":count", fmt.Sprintf("%d", v.Count),
+		":others", joinFieldNames(v, v.Paths),
+	}
+}
+
+// RequiredCount validates that exactly "count" of the fields identified by the
+// given paths are present. A field is considered present if it exists, is not `nil` and
+// is not an empty string, an empty slice or an empty object.
+// The paths are relative to the root element. This is useful for "choose 2 of 3" forms.
+//
+// Like `MutuallyExclusive()`, this validator is meant to be used on the `CurrentElement`
+// of a rule set (or on its parent object).
+//
+// Panics if "count" is negative or greater than the number of paths.
+func RequiredCount(count int, paths ...string) *RequiredCountValidator {
+	v, err := RequiredCountE(count, paths...)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// RequiredCountE is the same as `RequiredCount()` but returns an error instead of panicking
+// if the count is invalid or if one of the given paths cannot be parsed.
+func RequiredCountE(count int, paths ...string) (*RequiredCountValidator, error) {
+	if count < 0 || count > len(paths) {
+		return nil, fmt.Errorf("validation.RequiredCount: count must be between 0 and the number of paths (%d), %d given", len(paths), count)
+	}
+	p, err := parsePaths("validation.RequiredCount", paths)
+	if err != nil {
+		return nil, err
+	}
+	return &RequiredCountValidator{Count: count, Paths: p}, nil
+}
@@ -0,0 +1,204 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goyave.dev/goyave/v5/lang"
+)
+
+func TestMutuallyExclusiveValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := MutuallyExclusive("email", "contact.phone")
+		v.lang = lang.New().GetDefault()
+		assert.NotNil(t, v)
+		assert.Equal(t, "mutually_exclusive", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Equal(t, []string{":others", "email address, phone"}, v.MessagePlaceholders(&Context{}))
+
+		assert.Panics(t, func() {
+			MutuallyExclusive("email", "invalid[path.")
+		})
+
+		v2, err := MutuallyExclusiveE("email", "phone")
+		require.NoError(t, err)
+		assert.Len(t, v2.Paths, 2)
+		v2, err = MutuallyExclusiveE("invalid[path.")
+		require.Error(t, err)
+		assert.Nil(t, v2)
+	})
+
+	cases := []struct {
+		data  map[string]any
+		desc  string
+		paths []string
+		want  bool
+	}{
+		{desc: "one present", data: map[string]any{"email": "a@b.c"}, paths: []string{"email", "phone"}, want: true},
+		{desc: "none present", data: map[string]any{}, paths: []string{"email", "phone"}, want: true},
+		{desc: "two present", data: map[string]any{"email": "a@b.c", "phone": "0123"}, paths: []string{"email", "phone"}, want: false},
+		{desc: "nil is absent", data: map[string]any{"email": "a@b.c", "phone": nil}, paths: []string{"email", "phone"}, want: true},
+		{desc: "nested one present", data: map[string]any{"contact": map[string]any{"phone": "0123"}}, paths: []string{"contact.email", "contact.phone"}, want: true},
+		{desc: "nested two present", data: map[string]any{"contact": map[string]any{"email": "a@b.c", "phone": "0123"}}, paths: []string{"contact.email", "contact.phone"}, want: false},
+		{desc: "mixed depths", data: map[string]any{"email": "a@b.c", "contact": map[string]any{"phone": "0123"}}, paths: []string{"email", "contact.phone"}, want: false},
+		{desc: "empty string is absent", data: map[string]any{"email": "a@b.c", "phone": ""}, paths: []string{"email", "phone"}, want: true},
+		{desc: "empty slice is absent", data: map[string]any{"email": "a@b.c", "phones": []any{}}, paths: []string{"email", "phones"}, want: true},
+		{desc: "empty object is absent", data: map[string]any{"email": "a@b.c", "contact": map[string]any{}}, paths: []string{"email", "contact"}, want: true},
+		{desc: "all empty", data: map[string]any{"email": "", "phones": []string{}}, paths: []string{"email", "phones"}, want: true},
+		{desc: "zero number is present", data: map[string]any{"email": "a@b.c", "phone": 0}, paths: []string{"email", "phone"}, want: false},
+		{desc: "non-empty slice is present", data: map[string]any{"email": "a@b.c", "phones": []any{"0123"}}, paths: []string{"email", "phones"}, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			v := MutuallyExclusive(c.paths...)
+			assert.Equal(t, c.want, v.Validate(&Context{
+				Value: c.data,
+				Data:  c.data,
+			}))
+		})
+	}
+
+	t.Run("Validate", func(t *testing.T) {
+		errs, err := Validate(&Options{
+			Data: map[string]any{"email": "a@b.c", "phone": "0123"},
+			Rules: RuleSet{
+				{Path: CurrentElement, Rules: List{MutuallyExclusive("email", "phone")}},
+				{Path: "email", Rules: List{String()}},
+				{Path: "phone", Rules: List{String()}},
+			},
+			Language: lang.New().GetDefault(),
+		})
+		require.Empty(t, err)
+		require.NotNil(t, errs)
+		assert.Equal(t, []string{"Only one of the following fields can be provided: email address, phone."}, errs.Errors)
+	})
+}
+
+func TestRequiredTogetherValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := RequiredTogether("address_line", "address.zip")
+		v.lang = lang.New().GetDefault()
+		assert.NotNil(t, v)
+		assert.Equal(t, "required_together", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Equal(t, []string{":others", "address_line, zip"}, v.MessagePlaceholders(&Context{}))
+
+		assert.Panics(t, func() {
+			RequiredTogether("address_line", "invalid[path.")
+		})
+
+		v2, err := RequiredTogetherE("address_line", "zip")
+		require.NoError(t, err)
+		assert.Len(t, v2.Paths, 2)
+		v2, err = RequiredTogetherE("invalid[path.")
+		require.Error(t, err)
+		assert.Nil(t, v2)
+	})
+
+	cases := []struct {
+		data  map[string]any
+		desc  string
+		paths []string
+		want  bool
+	}{
+		{desc: "all present", data: map[string]any{"address_line": "1 street", "zip": "12345"}, paths: []string{"address_line", "zip"}, want: true},
+		{desc: "none present", data: map[string]any{}, paths: []string{"address_line", "zip"}, want: true},
+		{desc: "partial", data: map[string]any{"address_line": "1 street"}, paths: []string{"address_line", "zip"}, want: false},
+		{desc: "nil is absent", data: map[string]any{"address_line": "1 street", "zip": nil}, paths: []string{"address_line", "zip"}, want: false},
+		{desc: "nested all present", data: map[string]any{"address": map[string]any{"line": "1 street", "zip": "12345"}}, paths: []string{"address.line", "address.zip"}, want: true},
+		{desc: "nested partial", data: map[string]any{"address": map[string]any{"zip": "12345"}}, paths: []string{"address.line", "address.zip"}, want: false},
+		{desc: "empty string is absent", data: map[string]any{"address_line": "1 street", "zip": ""}, paths: []string{"address_line", "zip"}, want: false},
+		{desc: "all empty", data: map[string]any{"address_line": "", "zip": ""}, paths: []string{"address_line", "zip"}, want: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			v := RequiredTogether(c.paths...)
+			assert.Equal(t, c.want, v.Validate(&Context{
+				Value: c.data,
+				Data:  c.data,
+			}))
+		})
+	}
+}
+
+func TestRequiredCountValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := RequiredCount(2, "email", "contact.phone", "address")
+		v.lang = lang.New().GetDefault()
+		assert.NotNil(t, v)
+		assert.Equal(t, "required_count", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Equal(t, 2, v.Count)
+		assert.Equal(t, []string{":count", "2", ":others", "email address, phone, address"}, v.MessagePlaceholders(&Context{}))
+
+		assert.Panics(t, func() {
+			RequiredCount(1, "email", "invalid[path.")
+		})
+		assert.Panics(t, func() {
+			RequiredCount(-1, "email")
+		})
+		assert.Panics(t, func() {
+			RequiredCount(3, "email", "phone")
+		})
+
+		v2, err := RequiredCountE(1, "email", "phone")
+		require.NoError(t, err)
+		assert.Len(t, v2.Paths, 2)
+		v2, err = RequiredCountE(1, "invalid[path.")
+		require.Error(t, err)
+		assert.Nil(t, v2)
+		v2, err = RequiredCountE(2, "email")
+		require.Error(t, err)
+		assert.Nil(t, v2)
+	})
+
+	paths := []string{"email", "phone", "address"}
+	cases := []struct {
+		data  map[string]any
+		desc  string
+		count int
+		want  bool
+	}{
+		{desc: "exactly two", count: 2, data: map[string]any{"email": "a@b.c", "phone": "0123"}, want: true},
+		{desc: "three present", count: 2, data: map[string]any{"email": "a@b.c", "phone": "0123", "address": "1 street"}, want: false},
+		{desc: "one present", count: 2, data: map[string]any{"email": "a@b.c"}, want: false},
+		{desc: "nil is absent", count: 2, data: map[string]any{"email": "a@b.c", "phone": "0123", "address": nil}, want: true},
+		{desc: "none required", count: 0, data: map[string]any{}, want: true},
+		{desc: "all required", count: 3, data: map[string]any{"email": "a@b.c", "phone": "0123", "address": "1 street"}, want: true},
+		{desc: "empty string is absent", count: 2, data: map[string]any{"email": "a@b.c", "phone": ""}, want: false},
+		{desc: "empty string not counted", count: 2, data: map[string]any{"email": "a@b.c", "phone": "0123", "address": ""}, want: true},
+		{desc: "empty slice and object are absent", count: 1, data: map[string]any{"email": "a@b.c", "phone": []any{}, "address": map[string]any{}}, want: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			v := RequiredCount(c.count, paths...)
+			assert.Equal(t, c.want, v.Validate(&Context{
+				Value: c.data,
+				Data:  c.data,
+			}))
+		})
+	}
+
+	t.Run("Validate", func(t *testing.T) {
+		errs, err := Validate(&Options{
+			Data: map[string]any{"email": "a@b.c"},
+			Rules: RuleSet{
+				{Path: CurrentElement, Rules: List{RequiredCount(2, "email", "phone", "address")}},
+				{Path: "email", Rules: List{String()}},
+				{Path: "phone", Rules: List{String()}},
+				{Path: "address", Rules: List{String()}},
+			},
+			Language: lang.New().GetDefault(),
+		})
+		require.Empty(t, err)
+		require.NotNil(t, errs)
+		assert.Equal(t, []string{"Exactly 2 of the following fields must be provided: email address, phone, address."}, errs.Errors)
+	})
+}
@@ -0,0 +1,70 @@
+package validation
+
+import (
+	"context"
+	"time"
+
+	"goyave.dev/goyave/v5/lang"
+	"goyave.dev/goyave/v5/util/walk"
+)
+
+// Explain returns, for each field matched in the data, the ordered list of the names of
+// the validators that would be executed if the data was validated using the given `Options`.
+// The keys of the returned map are the exact paths to the fields (e.g. "array[2].field").
+//
+// This is a debugging tool: validators are NOT executed and neither the options nor
+// the data are modified. Absent fields that are not required (or whose `RequiredIf`
+// condition is not met) are not included in the result. For nullable fields with a `nil` value,
+// only the validators placed before `Nullable()` are listed. `Options.ConvertSingleValueArrays`
+// is taken into account.
+//
+// Because validators are not executed, type conversions don't happen. Therefore, the result
+// may differ from the actual validation if a validator used in a condition depends on a
+// converted value.
+func Explain(options *Options) map[string][]string {
+	opts := *options
+	if opts.Extra == nil {
+		opts.Extra = map[any]any{}
+	}
+	if opts.Language == nil {
+		opts.Language = lang.Default
+	}
+	if opts.Context == nil {
+		opts.Context = context.Background()
+	}
+	v := &validator{
+		options: &opts,
+		now:     opts.Now,
+	}
+	if v.now.IsZero() {
+		v.now = time.Now()
+	}
+
+	result := map[string][]string{}
+	explain := func(_ string, field *Field, c *walk.Context, parentPath *walk.Path, _ bool) {
+		names := make([]string, 0, len(field.Validators))
+		for _, validator := range field.Validators {
+			if _, ok := validator.(*NullableValidator); ok {
+				if c.Value == nil {
+					break
+				}
+				continue
+			}
+			names = append(names, validator.Name())
+		}
+		result[field.getErrorPath(parentPath, c).String()] = names
+	}
+
+	for _, field := range opts.Rules.AsRules() {
+		if field.Path.Name != nil && *field.Path.Name == CurrentElement {
+			fakeParent := map[string]any{}
+			if opts.Data != nil {
+				fakeParent[CurrentElement] = opts.Data
+			}
+			v.walkField(*field.Path.Name, field, fakeParent, nil, true, explain)
+		} else {
+			v.walkField(field.Path.Tail().String(), field, opts.Data, nil, true, explain)
+		}
+	}
+	return result
+}
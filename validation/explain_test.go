@@ -0,0 +1,133 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplain(t *testing.T) {
+	cases := []struct {
+		data  any
+		rules Ruler
+		want  map[string][]string
+		desc  string
+	}{
+		{
+			desc: "required",
+			data: map[string]any{"field": "a"},
+			rules: RuleSet{
+				{Path: "field", Rules: List{Required(), String(), Min(3)}},
+				{Path: "missing", Rules: List{Required(), String()}},
+				{Path: "optional", Rules: List{String()}},
+			},
+			want: map[string][]string{
+				"field":   {"required", "string", "min"},
+				"missing": {"required", "string"},
+			},
+		},
+		{
+			desc: "nullable",
+			data: map[string]any{"nullable": nil, "nullable_present": "a", "not_nullable": nil},
+			rules: RuleSet{
+				{Path: "nullable", Rules: List{Required(), Nullable(), String()}},
+				{Path: "nullable_present", Rules: List{Required(), Nullable(), String()}},
+				{Path: "not_nullable", Rules: List{String()}},
+			},
+			want: map[string][]string{
+				"nullable":         {"required"},
+				"nullable_present": {"required", "string"},
+			},
+		},
+		{
+			desc: "conditional",
+			data: map[string]any{"type": "company"},
+			rules: RuleSet{
+				{Path: "type", Rules: List{Required(), String()}},
+				{Path: "company_name", Rules: List{
+					RequiredIf(func(ctx *Context) bool {
+						return ctx.Data.(map[string]any)["type"] == "company"
+					}),
+					String(),
+				}},
+				{Path: "first_name", Rules: List{
+					RequiredIf(func(ctx *Context) bool {
+						return ctx.Data.(map[string]any)["type"] == "person"
+					}),
+					String(),
+				}},
+			},
+			want: map[string][]string{
+				"type":         {"required", "string"},
+				"company_name": {"required", "string"},
+			},
+		},
+		{
+			desc: "arrays_and_objects",
+			data: map[string]any{
+				"array":  []any{1, 2},
+				"object": map[string]any{"field": "a"},
+			},
+			rules: RuleSet{
+				{Path: "array", Rules: List{Required(), Array()}},
+				{Path: "array[]", Rules: List{Int()}},
+				{Path: "object", Rules: List{Required(), Object()}},
+				{Path: "object.field", Rules: List{String()}},
+			},
+			want: map[string][]string{
+				"array":        {"required", "array"},
+				"array[0]":     {"int"},
+				"array[1]":     {"int"},
+				"object":       {"required", "object"},
+				"object.field": {"string"},
+			},
+		},
+		{
+			desc: "root",
+			data: map[string]any{},
+			rules: RuleSet{
+				{Path: CurrentElement, Rules: List{Required(), Object()}},
+			},
+			want: map[string][]string{
+				"": {"required", "object"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			assert.Equal(t, c.want, Explain(&Options{Data: c.data, Rules: c.rules}))
+		})
+	}
+
+	t.Run("data_not_modified", func(t *testing.T) {
+		data := map[string]any{"nil": nil, "number": "12", "array": "a"}
+		opts := &Options{
+			Data: data,
+			Rules: RuleSet{
+				{Path: "nil", Rules: List{String()}},
+				{Path: "number", Rules: List{Float64()}},
+				{Path: "array", Rules: List{Array()}},
+			},
+			ConvertSingleValueArrays: true,
+		}
+		Explain(opts)
+		assert.Equal(t, map[string]any{"nil": nil, "number": "12", "array": "a"}, data)
+		assert.Nil(t, opts.Extra)
+		assert.Nil(t, opts.Language)
+	})
+
+	t.Run("convert_single_value_arrays", func(t *testing.T) {
+		opts := &Options{
+			Data: map[string]any{"array": "a"},
+			Rules: RuleSet{
+				{Path: "array", Rules: List{Required(), Array()}},
+				{Path: "array[]", Rules: List{String()}},
+			},
+		}
+		assert.Equal(t, map[string][]string{"array": {"required", "array"}}, Explain(opts))
+
+		opts.ConvertSingleValueArrays = true
+		assert.Equal(t, map[string][]string{"array": {"required", "array"}, "array[0]": {"string"}}, Explain(opts))
+	})
+}
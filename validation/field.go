@@ -39,6 +39,8 @@ func newField(path string, validators []Validator, prefixDepth uint) *Field {
 			f.isRequired = alwaysRequired
 		case *RequiredIfValidator:
 			f.isRequired = v.Condition
+		case *RequiredIfMatchesValidator:
+			f.isRequired = v.Condition
 		case *NullableValidator:
 			f.isNullable = true
 		case *ArrayValidator:
@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"strconv"
@@ -46,6 +47,12 @@ func numberAsFloat64(n any) (float64, bool, error) {
 			return float64(val), false, fmt.Errorf("uint64, value %d doesn't fit in float64", val)
 		}
 		return float64(val), true, nil
+	case json.Number:
+		f, err := val.Float64()
+		if err != nil {
+			return 0, false, fmt.Errorf("json.Number value %q is not a valid float64: %w", val, err)
+		}
+		return f, true, nil
 	}
 	return 0, false, nil
 }
@@ -68,6 +75,9 @@ func (v *floatValidator[T]) Validate(ctx *Context) bool {
 		return v.checkFloatRange(ctx, val)
 	case string:
 		return v.parseString(ctx, val)
+	case json.Number:
+		floatVal, err := val.Float64()
+		return err == nil && v.checkFloatRange(ctx, floatVal)
 	case int:
 		return v.checkIntRange(ctx, val)
 	case int8:
@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"strconv"
@@ -31,6 +32,8 @@ func TestFloat32Validator(t *testing.T) {
 		{value: float32(2.5), want: true, wantValue: float32(2.5)},
 		{value: uint(2), want: true, wantValue: float32(2.0)},
 		{value: 'a', want: true, wantValue: float32(97.0)},
+		{value: json.Number("2.5"), want: true, wantValue: float32(2.5)},
+		{value: json.Number("1e300"), want: false},
 		{value: "2.5", want: true, wantValue: float32(2.5)},
 		{value: strconv.FormatFloat(math.MaxFloat32, 'f', 24, 64), want: true, wantValue: float32(math.MaxFloat32)},
 		{value: strconv.FormatFloat(-math.MaxFloat32, 'f', 24, 64), want: true, wantValue: float32(-math.MaxFloat32)},
@@ -101,6 +104,10 @@ func TestFloat64Validator(t *testing.T) {
 		{value: float32(math.MaxFloat32), want: true, wantValue: float64(math.MaxFloat32)},
 		{value: uint(2), want: true, wantValue: float64(2.0)},
 		{value: 'a', want: true, wantValue: float64(97.0)},
+		{value: json.Number("2.5"), want: true, wantValue: float64(2.5)},
+		{value: json.Number("2"), want: true, wantValue: float64(2.0)},
+		{value: json.Number("1e400"), want: false},
+		{value: json.Number("abc"), want: false},
 		{value: "2.5", want: true, wantValue: float64(2.5)},
 		{value: strconv.FormatFloat(math.MaxFloat64, 'f', 24, 64), want: true, wantValue: float64(math.MaxFloat64)},
 		{value: strconv.FormatFloat(-math.MaxFloat64, 'f', 24, 64), want: true, wantValue: float64(-math.MaxFloat64)},
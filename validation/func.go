@@ -0,0 +1,45 @@
+package validation
+
+// FuncValidator validates the field under validation using a function returning an error,
+// as found in other validation libraries. The field passes if the function returns `nil`.
+// The error message is the text of the returned error.
+type FuncValidator struct {
+	BaseValidator
+	Func func(value any) error
+	name string
+	err  error
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *FuncValidator) Validate(ctx *Context) bool {
+	v.err = v.Func(ctx.Value)
+	return v.err == nil
+}
+
+// Name returns the string name of the validator.
+func (v *FuncValidator) Name() string { return v.name }
+
+// MessagePlaceholders returns the ":error" placeholder, containing the text
+// of the error returned by the function.
+func (v *FuncValidator) MessagePlaceholders(_ *Context) []string {
+	message := ""
+	if v.err != nil {
+		message = v.err.Error()
+	}
+	return []string{
+		":error", message,
+	}
+}
+
+// FromFunc creates a validator with the given name from a function returning an error,
+// making it easy to reuse validators from other libraries.
+// The field under validation passes if the function returns `nil`.
+//
+// By default, the error message is the text of the returned error (lang entry
+// "validation.rules.from_func"). Use `WithMessage()` to use a translated message instead. The
+// text of the error is available in the ":error" placeholder.
+func FromFunc(name string, fn func(value any) error) *FuncValidator {
+	v := &FuncValidator{Func: fn, name: name}
+	v.overrideMessage("validation.rules.from_func")
+	return v
+}
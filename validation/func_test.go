@@ -0,0 +1,84 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goyave.dev/goyave/v5/lang"
+)
+
+func TestFuncValidator(t *testing.T) {
+	positive := func(value any) error {
+		n, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("value must be an integer")
+		}
+		if n <= 0 {
+			return fmt.Errorf("value must be positive")
+		}
+		return nil
+	}
+
+	t.Run("Constructor", func(t *testing.T) {
+		v := FromFunc("positive", positive)
+		assert.NotNil(t, v)
+		assert.Equal(t, "positive", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Equal(t, []string{":error", ""}, v.MessagePlaceholders(&Context{}))
+		assert.Equal(t, "validation.rules.from_func", v.getMessageOverride())
+	})
+
+	cases := []struct {
+		value     any
+		wantError string
+		want      bool
+	}{
+		{value: 1, want: true},
+		{value: 0, want: false, wantError: "value must be positive"},
+		{value: -1, want: false, wantError: "value must be positive"},
+		{value: "1", want: false, wantError: "value must be an integer"},
+		{value: nil, want: false, wantError: "value must be an integer"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%t", c.value, c.want), func(t *testing.T) {
+			v := FromFunc("positive", positive)
+			ctx := &Context{Value: c.value}
+			assert.Equal(t, c.want, v.Validate(ctx))
+			assert.Equal(t, []string{":error", c.wantError}, v.MessagePlaceholders(ctx))
+		})
+	}
+
+	t.Run("message", func(t *testing.T) {
+		validationErrors, errs := Validate(&Options{
+			Data:     map[string]any{"count": 0, "values": []any{1, -1}},
+			Language: lang.New().GetDefault(),
+			Rules: RuleSet{
+				{Path: "count", Rules: List{Required(), Int(), FromFunc("positive", positive)}},
+				{Path: "values", Rules: List{Required(), Array()}},
+				{Path: "values[]", Rules: List{Int(), FromFunc("positive", positive)}},
+			},
+		})
+		require.Empty(t, errs)
+		require.NotNil(t, validationErrors)
+		assert.Equal(t, []string{"value must be positive"}, validationErrors.Fields["count"].Errors)
+		assert.Equal(t, []string{"value must be positive"}, validationErrors.Fields["values"].Elements[1].Errors)
+	})
+
+	t.Run("custom_message", func(t *testing.T) {
+		language := lang.New().GetDefault()
+		validationErrors, errs := Validate(&Options{
+			Data:     map[string]any{"count": 0},
+			Language: language,
+			Rules: RuleSet{
+				{Path: "count", Rules: List{Required(), WithMessage(FromFunc("positive", positive), "validation.rules.required")}},
+			},
+		})
+		require.Empty(t, errs)
+		require.NotNil(t, validationErrors)
+		assert.Equal(t, []string{"The count is required."}, validationErrors.Fields["count"].Errors)
+	})
+}
@@ -0,0 +1,67 @@
+package validation
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Coordinates a pair of geographic coordinates in decimal degrees.
+type Coordinates struct {
+	Lat float64
+	Lng float64
+}
+
+// LatLngValidator validates the field under validation must be a string representing
+// a pair of geographic coordinates in decimal degrees, separated by a comma ("lat,lng").
+// The latitude must be between -90 and 90, and the longitude between -180 and 180.
+// If validation passes, the value is converted to `Coordinates`.
+type LatLngValidator struct{ BaseValidator }
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *LatLngValidator) Validate(ctx *Context) bool {
+	if _, ok := ctx.Value.(Coordinates); ok {
+		return true
+	}
+	str, ok := ctx.Value.(string)
+	if !ok {
+		return false
+	}
+	latStr, lngStr, ok := strings.Cut(str, ",")
+	if !ok {
+		return false
+	}
+	lat, ok := parseCoordinate(latStr, 90)
+	if !ok {
+		return false
+	}
+	lng, ok := parseCoordinate(lngStr, 180)
+	if !ok {
+		return false
+	}
+	ctx.Value = Coordinates{Lat: lat, Lng: lng}
+	return true
+}
+
+func parseCoordinate(str string, limit float64) (float64, bool) {
+	n, err := strconv.ParseFloat(strings.TrimSpace(str), 64)
+	if err != nil || math.IsNaN(n) || n < -limit || n > limit {
+		return 0, false
+	}
+	return n, true
+}
+
+// Name returns the string name of the validator.
+func (v *LatLngValidator) Name() string { return "lat_lng" }
+
+// IsType returns true.
+func (v *LatLngValidator) IsType() bool { return true }
+
+// LatLng the field under validation must be a string representing a pair of geographic
+// coordinates in decimal degrees, separated by a comma (e.g. "48.8584,2.2945").
+// Spaces around the components are allowed. The latitude must be between -90 and 90,
+// and the longitude between -180 and 180.
+// If validation passes, the value is converted to `Coordinates`.
+func LatLng() *LatLngValidator {
+	return &LatLngValidator{}
+}
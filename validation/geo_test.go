@@ -0,0 +1,70 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goyave.dev/goyave/v5/lang"
+)
+
+func TestLatLngValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := LatLng()
+		assert.NotNil(t, v)
+		assert.Equal(t, "lat_lng", v.Name())
+		assert.True(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+	})
+
+	cases := []struct {
+		value     any
+		wantValue any
+		want      bool
+	}{
+		{value: "48.8584,2.2945", want: true, wantValue: Coordinates{Lat: 48.8584, Lng: 2.2945}},
+		{value: "-33.8568, 151.2153", want: true, wantValue: Coordinates{Lat: -33.8568, Lng: 151.2153}},
+		{value: "90,-180", want: true, wantValue: Coordinates{Lat: 90, Lng: -180}},
+		{value: "0,0", want: true, wantValue: Coordinates{}},
+		{value: Coordinates{Lat: 1, Lng: 2}, want: true, wantValue: Coordinates{Lat: 1, Lng: 2}},
+		{value: "151.2153,-33.8568", want: false},
+		{value: "-90.1,0", want: false},
+		{value: "0,180.5", want: false},
+		{value: "NaN,0", want: false},
+		{value: "0,Inf", want: false},
+		{value: "48.8584", want: false},
+		{value: "48.8584,2.2945,1", want: false},
+		{value: "48.8584;2.2945", want: false},
+		{value: "a,b", want: false},
+		{value: ",", want: false},
+		{value: "", want: false},
+		{value: 48.8584, want: false},
+		{value: []float64{48.8584, 2.2945}, want: false},
+		{value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%t", c.value, c.want), func(t *testing.T) {
+			v := LatLng()
+			ctx := &Context{Value: c.value}
+			ok := v.Validate(ctx)
+			if assert.Equal(t, c.want, ok) && ok {
+				assert.Equal(t, c.wantValue, ctx.Value)
+			}
+		})
+	}
+
+	t.Run("conversion", func(t *testing.T) {
+		data := map[string]any{"location": "48.8584, 2.2945"}
+		errs, err := Validate(&Options{
+			Data:     data,
+			Rules:    RuleSet{{Path: "location", Rules: List{Required(), LatLng()}}},
+			Language: lang.New().GetDefault(),
+		})
+		require.Empty(t, err)
+		assert.Nil(t, errs)
+		assert.Equal(t, Coordinates{Lat: 48.8584, Lng: 2.2945}, data["location"])
+	})
+}
@@ -0,0 +1,40 @@
+package validation
+
+// MatchesHashValidator the field under validation must be a string matching the
+// given hash according to the `Compare` function. This is useful to check the current
+// password of a user in a password change form for example.
+//
+// The comparison is delegated to the `Compare` function so the validation package
+// doesn't depend on a specific hashing algorithm (e.g. bcrypt or argon2).
+type MatchesHashValidator struct {
+	BaseValidator
+	Compare func(hash, plaintext string) bool
+	Hash    string
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *MatchesHashValidator) Validate(ctx *Context) bool {
+	val, ok := ctx.Value.(string)
+	if !ok {
+		return false
+	}
+	return v.Compare(v.Hash, val)
+}
+
+// Name returns the string name of the validator.
+func (v *MatchesHashValidator) Name() string { return "matches_hash" }
+
+// MatchesHash the field under validation must be a string matching the given hash according
+// to the given comparison function. This is useful to check the current password of a user
+// in a password change form for example:
+//
+//	v.MatchesHash(user.Password, func(hash, plaintext string) bool {
+//		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(plaintext)) == nil
+//	})
+//
+// The comparison is delegated to the given function so the validation package
+// doesn't depend on a specific hashing algorithm. The hash is never included in the
+// validation error message.
+func MatchesHash(hash string, compare func(hash, plaintext string) bool) *MatchesHashValidator {
+	return &MatchesHashValidator{Hash: hash, Compare: compare}
+}
@@ -0,0 +1,60 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goyave.dev/goyave/v5/lang"
+)
+
+func stubCompare(hash, plaintext string) bool {
+	return hash == "hashed:"+plaintext
+}
+
+func TestMatchesHashValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := MatchesHash("hashed:secret", stubCompare)
+		assert.NotNil(t, v)
+		assert.Equal(t, "matches_hash", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+		assert.Equal(t, "hashed:secret", v.Hash)
+		assert.NotNil(t, v.Compare)
+	})
+
+	cases := []struct {
+		value any
+		want  bool
+	}{
+		{value: "secret", want: true},
+		{value: "wrong", want: false},
+		{value: "", want: false},
+		{value: 1, want: false},
+		{value: []byte("secret"), want: false},
+		{value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%t", c.value, c.want), func(t *testing.T) {
+			v := MatchesHash("hashed:secret", stubCompare)
+			assert.Equal(t, c.want, v.Validate(&Context{
+				Value: c.value,
+			}))
+		})
+	}
+
+	t.Run("message", func(t *testing.T) {
+		errs, err := Validate(&Options{
+			Data:     map[string]any{"current_password": "wrong"},
+			Rules:    RuleSet{{Path: "current_password", Rules: List{Required(), String(), MatchesHash("hashed:secret", stubCompare)}}},
+			Language: lang.New().GetDefault(),
+		})
+		require.Empty(t, err)
+		require.NotNil(t, errs)
+		assert.Equal(t, []string{"The current_password is incorrect."}, errs.Fields["current_password"].Errors)
+		assert.NotContains(t, errs.Fields["current_password"].Errors[0], "hashed")
+	})
+}
@@ -0,0 +1,32 @@
+package validation
+
+// HTTPHeaderValueValidator validates the field under validation must be a string that
+// can safely be used as an HTTP header value: it must not contain control characters
+// (including CR, LF and NUL) other than horizontal tabs, as per RFC 9110.
+type HTTPHeaderValueValidator struct{ BaseValidator }
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *HTTPHeaderValueValidator) Validate(ctx *Context) bool {
+	str, ok := ctx.Value.(string)
+	if !ok {
+		return false
+	}
+	for i := 0; i < len(str); i++ {
+		b := str[i]
+		if (b < ' ' && b != '\t') || b == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+// Name returns the string name of the validator.
+func (v *HTTPHeaderValueValidator) Name() string { return "http_header" }
+
+// HTTPHeaderValue the field under validation must be a string that can safely be used as
+// an HTTP header value: it must not contain control characters (including CR, LF and NUL)
+// other than horizontal tabs, as per RFC 9110. This prevents header injection
+// (response splitting) when user input is written in a response header.
+func HTTPHeaderValue() *HTTPHeaderValueValidator {
+	return &HTTPHeaderValueValidator{}
+}
@@ -0,0 +1,46 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPHeaderValueValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := HTTPHeaderValue()
+		assert.NotNil(t, v)
+		assert.Equal(t, "http_header", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+	})
+
+	cases := []struct {
+		value any
+		want  bool
+	}{
+		{value: "attachment; filename=\"report.pdf\"", want: true},
+		{value: "text/html; charset=utf-8", want: true},
+		{value: "a\tb", want: true},
+		{value: "héllo", want: true},
+		{value: "", want: true},
+		{value: "value\r\nSet-Cookie: session=attacker", want: false},
+		{value: "value\nX-Injected: 1", want: false},
+		{value: "value\r", want: false},
+		{value: "val\x00ue", want: false},
+		{value: "val\x1bue", want: false},
+		{value: "val\x7fue", want: false},
+		{value: 1, want: false},
+		{value: []byte("value"), want: false},
+		{value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%q_%t", c.value, c.want), func(t *testing.T) {
+			v := HTTPHeaderValue()
+			assert.Equal(t, c.want, v.Validate(&Context{Value: c.value}))
+		})
+	}
+}
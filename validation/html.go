@@ -0,0 +1,63 @@
+package validation
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// simpleTagRegex matches an opening, closing or self-closing tag without attributes at the start of a string.
+var simpleTagRegex = regexp.MustCompile(`^</?([a-zA-Z][a-zA-Z0-9]*)\s*/?>`)
+
+// NoHTMLTagsValidator validates the field under validation must be a string that doesn't
+// contain any HTML tag, comment or declaration. Tags listed in `AllowedTags` are accepted
+// as long as they don't have any attribute.
+// A "<" character not followed by a letter, "/", "!" or "?" is not considered
+// as the start of a tag (e.g. "a < b").
+type NoHTMLTagsValidator struct {
+	BaseValidator
+	AllowedTags []string
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *NoHTMLTagsValidator) Validate(ctx *Context) bool {
+	str, ok := ctx.Value.(string)
+	if !ok {
+		return false
+	}
+	for {
+		i := strings.IndexByte(str, '<')
+		if i == -1 || i == len(str)-1 {
+			return true
+		}
+		str = str[i:]
+		if !isTagStart(str[1]) {
+			str = str[1:]
+			continue
+		}
+		match := simpleTagRegex.FindStringSubmatch(str)
+		if match == nil || !lo.Contains(v.AllowedTags, strings.ToLower(match[1])) {
+			return false
+		}
+		str = str[len(match[0]):]
+	}
+}
+
+func isTagStart(c byte) bool {
+	return c == '/' || c == '!' || c == '?' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// Name returns the string name of the validator.
+func (v *NoHTMLTagsValidator) Name() string { return "no_html" }
+
+// NoHTMLTags the field under validation must be a string that doesn't contain any HTML tag,
+// comment or declaration. This is useful to keep plain-text fields plain.
+//
+// The given tags (e.g. "b", "i", "br") are accepted as long as they don't have any attribute:
+// "<b>" and "</b>" pass but `<b onclick="...">` doesn't. Tag names are case-insensitive.
+//
+// This validator is not a replacement for escaping the output.
+func NoHTMLTags(allowedTags ...string) *NoHTMLTagsValidator {
+	return &NoHTMLTagsValidator{AllowedTags: lo.Map(allowedTags, func(tag string, _ int) string { return strings.ToLower(tag) })}
+}
@@ -0,0 +1,56 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoHTMLTagsValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := NoHTMLTags("b", "BR")
+		assert.NotNil(t, v)
+		assert.Equal(t, "no_html", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+		assert.Equal(t, []string{"b", "br"}, v.AllowedTags)
+	})
+
+	cases := []struct {
+		value       any
+		allowedTags []string
+		want        bool
+	}{
+		{value: "plain text", want: true},
+		{value: "", want: true},
+		{value: "a < b and b > c", want: true},
+		{value: "1<2", want: true},
+		{value: "ends with <", want: true},
+		{value: "<3 love", want: true},
+		{value: "<script>alert(1)</script>", want: false},
+		{value: "text <SCRIPT src=x></SCRIPT>", want: false},
+		{value: "<img src=x onerror=alert(1)>", want: false},
+		{value: "<b>bold</b>", want: false},
+		{value: "</b>", want: false},
+		{value: "<!-- comment -->", want: false},
+		{value: "<!DOCTYPE html>", want: false},
+		{value: "<?xml version=\"1.0\"?>", want: false},
+		{value: "<b>bold</b> and <i>italic</i>", allowedTags: []string{"b", "i"}, want: true},
+		{value: "<B>bold</B><br/><br />", allowedTags: []string{"b", "br"}, want: true},
+		{value: "<b onclick=\"alert(1)\">bold</b>", allowedTags: []string{"b"}, want: false},
+		{value: "<b>bold</b><script>alert(1)</script>", allowedTags: []string{"b"}, want: false},
+		{value: "<b", allowedTags: []string{"b"}, want: false},
+		{value: 1, want: false},
+		{value: []string{"<b>"}, want: false},
+		{value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%t", c.value, c.want), func(t *testing.T) {
+			v := NoHTMLTags(c.allowedTags...)
+			assert.Equal(t, c.want, v.Validate(&Context{Value: c.value}))
+		})
+	}
+}
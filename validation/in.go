@@ -30,20 +30,78 @@ func (v *InValidator[T]) Validate(ctx *Context) bool {
 // Name returns the string name of the validator.
 func (v *InValidator[T]) Name() string { return "in" }
 
-// MessagePlaceholders returns the ":values placeholder.
+// ListValues returns the accepted values as a slice of `any`.
+// They are used for the ":values" placeholder.
+func (v *InValidator[T]) ListValues() []any {
+	return lo.ToAnySlice(v.Values)
+}
+
+// MessagePlaceholders returns the ":values" placeholder.
 func (v *InValidator[T]) MessagePlaceholders(_ *Context) []string {
 	return []string{
-		":values", strings.Join(lo.Map(v.Values, func(v T, _ int) string { return fmt.Sprintf("%v", v) }), ", "),
+		":values", renderValues(v.ListValues()),
 	}
 }
 
 // In the field under validation must be a one of the given values.
+//
+// The values are a regular Go slice, so they can be computed at runtime (loaded from
+// the database at startup for example) and can contain any character, including commas.
 func In[T comparable](values []T) *InValidator[T] {
 	return &InValidator[T]{Values: values}
 }
 
 //------------------------------
 
+// InLangKeysValidator validates the field under validation must be a string
+// matching one of the keys under the given prefix in the current language.
+// For example, with the prefix "status.", the value "active" is accepted
+// if the language contains the "status.active" line.
+type InLangKeysValidator struct {
+	BaseValidator
+	Prefix string
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *InLangKeysValidator) Validate(ctx *Context) bool {
+	val, ok := ctx.Value.(string)
+	if !ok {
+		return false
+	}
+	return v.Lang().HasLine(v.Prefix + val)
+}
+
+// Name returns the string name of the validator.
+func (v *InLangKeysValidator) Name() string { return "in" }
+
+// ListValues returns the accepted keys (without the prefix) as a slice of `any`.
+// They are used for the ":values" placeholder.
+func (v *InLangKeysValidator) ListValues() []any {
+	keys := v.Lang().Keys(v.Prefix)
+	values := make([]any, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, strings.TrimPrefix(key, v.Prefix))
+	}
+	return values
+}
+
+// MessagePlaceholders returns the ":values" placeholder.
+func (v *InLangKeysValidator) MessagePlaceholders(_ *Context) []string {
+	return []string{
+		":values", renderValues(v.ListValues()),
+	}
+}
+
+// InLangKeys the field under validation must be a string matching one of the keys
+// under the given prefix in the current language (e.g. "status."). This keeps the accepted
+// values and their translated labels in a single place: the language files.
+// Only normal language lines are checked, not validation rules messages nor field names.
+func InLangKeys(prefix string) *InLangKeysValidator {
+	return &InLangKeysValidator{Prefix: prefix}
+}
+
+//------------------------------
+
 // NotInValidator validates the field undervalidation must not be a one of the given values.
 type NotInValidator[T comparable] struct {
 	BaseValidator
@@ -66,10 +124,16 @@ func (v *NotInValidator[T]) Validate(ctx *Context) bool {
 // Name returns the string name of the validator.
 func (v *NotInValidator[T]) Name() string { return "not_in" }
 
-// MessagePlaceholders returns the ":values placeholder.
+// ListValues returns the rejected values as a slice of `any`.
+// They are used for the ":values" placeholder.
+func (v *NotInValidator[T]) ListValues() []any {
+	return lo.ToAnySlice(v.Values)
+}
+
+// MessagePlaceholders returns the ":values" placeholder.
 func (v *NotInValidator[T]) MessagePlaceholders(_ *Context) []string {
 	return []string{
-		":values", strings.Join(lo.Map(v.Values, func(v T, _ int) string { return fmt.Sprintf("%v", v) }), ", "),
+		":values", renderValues(v.ListValues()),
 	}
 }
 
@@ -128,11 +192,21 @@ func (v *InFieldValidator[T]) MessagePlaceholders(_ *Context) []string {
 // InField the field under validation must be in at least one
 // of the arrays matched by the specified path.
 func InField[T comparable](path string) *InFieldValidator[T] {
+	v, err := InFieldE[T](path)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// InFieldE is the same as `InField()` but returns an error instead of panicking
+// if the given path cannot be parsed.
+func InFieldE[T comparable](path string) (*InFieldValidator[T], error) {
 	p, err := walk.Parse(path)
 	if err != nil {
-		panic(errors.NewSkip(fmt.Errorf("validation.InField: path parse error: %w", err), 3))
+		return nil, fmt.Errorf("validation.InField: path parse error: %w", err)
 	}
-	return &InFieldValidator[T]{Path: p}
+	return &InFieldValidator[T]{Path: p}, nil
 }
 
 //------------------------------
@@ -160,9 +234,19 @@ func (v *NotInFieldValidator[T]) Name() string { return "not_in_field" }
 // NotInField the field under validation must not be in any
 // of the arrays matched by the specified path.
 func NotInField[T comparable](path string) *NotInFieldValidator[T] {
+	v, err := NotInFieldE[T](path)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// NotInFieldE is the same as `NotInField()` but returns an error instead of panicking
+// if the given path cannot be parsed.
+func NotInFieldE[T comparable](path string) (*NotInFieldValidator[T], error) {
 	p, err := walk.Parse(path)
 	if err != nil {
-		panic(errors.NewSkip(fmt.Errorf("validation.NotInField: path parse error: %w", err), 3))
+		return nil, fmt.Errorf("validation.NotInField: path parse error: %w", err)
 	}
-	return &NotInFieldValidator[T]{InFieldValidator: InFieldValidator[T]{Path: p}}
+	return &NotInFieldValidator[T]{InFieldValidator: InFieldValidator[T]{Path: p}}, nil
 }
@@ -2,11 +2,14 @@ package validation
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
+	"testing/fstest"
 	"time"
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"goyave.dev/goyave/v5/lang"
 )
 
@@ -24,6 +27,7 @@ func TestInValidator(t *testing.T) {
 		assert.False(t, v.IsType())
 		assert.False(t, v.IsTypeDependent())
 		assert.Equal(t, []string{":values", "a, b, c"}, v.MessagePlaceholders(&Context{}))
+		assert.Equal(t, []any{"a", "b", "c"}, v.ListValues())
 	})
 
 	cases := []inTestCase[string]{
@@ -50,6 +54,109 @@ func TestInValidator(t *testing.T) {
 			assert.Equal(t, c.want, v.Validate(ctx))
 		})
 	}
+
+	t.Run("dynamic_values", func(t *testing.T) {
+		// Values computed at runtime (loaded from a database for example) are used
+		// as-is: no parsing is involved so they can contain commas.
+		values := make([]string, 0, 3)
+		for _, city := range []string{"Paris, France", "Paris, Texas", "Berlin"} {
+			values = append(values, city)
+		}
+		v := In(values)
+		assert.True(t, v.Validate(&Context{Value: "Paris, Texas"}))
+		assert.False(t, v.Validate(&Context{Value: "Paris"}))
+		assert.False(t, v.Validate(&Context{Value: "Texas"}))
+
+		errs, err := Validate(&Options{
+			Data:     map[string]any{"city": "Paris"},
+			Rules:    RuleSet{{Path: "city", Rules: List{In(values)}}},
+			Language: lang.New().GetDefault(),
+		})
+		require.Empty(t, err)
+		require.NotNil(t, errs)
+		assert.Equal(t, []string{"The city must have one of the following values: Paris, France, Paris, Texas, Berlin."}, errs.Fields["city"].Errors)
+	})
+
+	t.Run("wrapped", func(t *testing.T) {
+		// Wrappers only forward the methods of the Validator interface
+		always := func(_ *Context) bool { return true }
+		errs, err := Validate(&Options{
+			Data: map[string]any{"status": "d", "role": "admin"},
+			Rules: RuleSet{
+				{Path: "status", Rules: List{OnlyIf(always, In([]string{"a", "b", "c"}))}},
+				{Path: "role", Rules: ForMethod(http.MethodPost, NotIn([]string{"admin", "root"}))},
+			},
+			Language: lang.New().GetDefault(),
+			Method:   http.MethodPost,
+		})
+		require.Empty(t, err)
+		require.NotNil(t, errs)
+		assert.Equal(t, []string{"The status must have one of the following values: a, b, c."}, errs.Fields["status"].Errors)
+		assert.Equal(t, []string{"The role must not have one of the following values: admin, root."}, errs.Fields["role"].Errors)
+	})
+}
+
+func TestInLangKeysValidator(t *testing.T) {
+	languages := lang.New()
+	langFS := fstest.MapFS{
+		"en-US/locale.json": {Data: []byte(`{"status.active": "Active", "status.archived": "Archived", "other": "Other"}`)},
+	}
+	require.NoError(t, languages.Load(langFS, "en-US", "en-US"))
+	language := languages.GetLanguage("en-US")
+
+	t.Run("Constructor", func(t *testing.T) {
+		v := InLangKeys("status.")
+		assert.NotNil(t, v)
+		assert.Equal(t, "in", v.Name())
+		assert.Equal(t, "status.", v.Prefix)
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+
+		v.Init(&Options{Language: language})
+		assert.Equal(t, []any{"active", "archived"}, v.ListValues())
+		assert.Equal(t, []string{":values", "active, archived"}, v.MessagePlaceholders(&Context{}))
+	})
+
+	cases := []struct {
+		value any
+		want  bool
+	}{
+		{value: "active", want: true},
+		{value: "archived", want: true},
+		{value: "deleted", want: false},
+		{value: "other", want: false},
+		{value: "status.active", want: false},
+		{value: "", want: false},
+		{value: 1, want: false},
+		{value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%t", c.value, c.want), func(t *testing.T) {
+			v := InLangKeys("status.")
+			v.Init(&Options{Language: language})
+			assert.Equal(t, c.want, v.Validate(&Context{Value: c.value}))
+		})
+	}
+
+	t.Run("message", func(t *testing.T) {
+		errs, err := Validate(&Options{
+			Data:     map[string]any{"status": "deleted"},
+			Rules:    RuleSet{{Path: "status", Rules: List{InLangKeys("status.")}}},
+			Language: language,
+		})
+		require.Empty(t, err)
+		require.NotNil(t, errs)
+		assert.Equal(t, []string{"The status must have one of the following values: active, archived."}, errs.Fields["status"].Errors)
+	})
+
+	t.Run("normal_lines_only", func(t *testing.T) {
+		// Rule messages and field names are not language keys
+		v := InLangKeys("validation.")
+		v.Init(&Options{Language: lang.New().GetDefault()})
+		assert.False(t, v.Validate(&Context{Value: "rules.required"}))
+		assert.False(t, v.Validate(&Context{Value: "fields.email"}))
+	})
 }
 
 func TestNotInValidator(t *testing.T) {
@@ -60,6 +167,7 @@ func TestNotInValidator(t *testing.T) {
 		assert.False(t, v.IsType())
 		assert.False(t, v.IsTypeDependent())
 		assert.Equal(t, []string{":values", "a, b, c"}, v.MessagePlaceholders(&Context{}))
+		assert.Equal(t, []any{"a", "b", "c"}, v.ListValues())
 	})
 
 	cases := []inTestCase[string]{
@@ -100,6 +208,13 @@ func TestInFieldValidator(t *testing.T) {
 		assert.Panics(t, func() {
 			InField[string](".path[")
 		})
+
+		v2, err := InFieldE[string]("field")
+		require.NoError(t, err)
+		assert.Equal(t, "field", v2.Path.String())
+		v2, err = InFieldE[string](".path[")
+		require.Error(t, err)
+		assert.Nil(t, v2)
 	})
 
 	cases := []struct {
@@ -162,6 +277,13 @@ func TestNotInFieldValidator(t *testing.T) {
 		assert.Panics(t, func() {
 			NotInField[string](".path[")
 		})
+
+		v2, err := NotInFieldE[string]("field")
+		require.NoError(t, err)
+		assert.Equal(t, "field", v2.Path.String())
+		v2, err = NotInFieldE[string](".path[")
+		require.Error(t, err)
+		assert.Nil(t, v2)
 	})
 
 	cases := []struct {
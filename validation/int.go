@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"strconv"
@@ -23,6 +24,8 @@ func (v *intValidator[T]) Validate(ctx *Context) bool {
 		return v.checkFloat64Range(ctx, val)
 	case string:
 		return v.parseString(ctx, val)
+	case json.Number:
+		return v.parseNumber(ctx, val)
 	case int:
 		return v.checkIntRange(ctx, val)
 	case int8:
@@ -147,6 +150,20 @@ func (v *intValidator[T]) parseString(ctx *Context, val string) bool {
 	return err == nil
 }
 
+// parseNumber parses the given `json.Number` as an integer. Numbers written
+// in float notation (e.g. "2.0" or "1e3") are accepted if they don't have a decimal.
+func (v *intValidator[T]) parseNumber(ctx *Context, val json.Number) bool {
+	if v.parseString(ctx, val.String()) {
+		return true
+	}
+	if _, err := val.Int64(); err == nil {
+		// Valid integer, but out of range for the target type.
+		return false
+	}
+	floatVal, err := val.Float64()
+	return err == nil && v.checkFloat64Range(ctx, floatVal)
+}
+
 func (v *intValidator[T]) Name() string {
 	var t T
 	switch any(t).(type) {
@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"testing"
@@ -52,6 +53,14 @@ func TestIntValidator(t *testing.T) {
 		{value: 2.0, want: true, wantValue: int(2)},
 		{value: float32(2.0), want: true, wantValue: int(2)},
 		{value: "2", want: true, wantValue: int(2)},
+		{value: json.Number("2"), want: true, wantValue: int(2)},
+		{value: json.Number("-2"), want: true, wantValue: int(-2)},
+		{value: json.Number("2.0"), want: true, wantValue: int(2)},
+		{value: json.Number("1e3"), want: true, wantValue: int(1000)},
+		{value: json.Number("2.5"), want: false},
+		{value: json.Number("9223372036854775808"), want: false},
+		{value: json.Number("1e300"), want: false},
+		{value: json.Number("abc"), want: false},
 		{value: 2.5, want: false},
 		{value: float32(2.5), want: false},
 		{value: 'a', want: true, wantValue: int(97)},
@@ -121,6 +130,8 @@ func TestInt8Validator(t *testing.T) {
 		{value: float64(math.MinInt8), want: true, wantValue: int8(math.MinInt8)},
 		{value: float32(math.MaxInt8), want: true, wantValue: int8(math.MaxInt8)},
 		{value: float32(math.MinInt8), want: true, wantValue: int8(math.MinInt8)},
+		{value: json.Number("127"), want: true, wantValue: int8(math.MaxInt8)},
+		{value: json.Number("128"), want: false},
 		{value: 2.0, want: true, wantValue: int8(2)},
 		{value: float32(2.0), want: true, wantValue: int8(2)},
 		{value: "2", want: true, wantValue: int8(2)},
@@ -702,6 +713,9 @@ func TestUint64Validator(t *testing.T) {
 		{value: float32(-maxIntFloat32), want: false},
 		{value: fmt.Sprintf("%d", uint(math.MaxUint)), want: true, wantValue: uint64(math.MaxUint)},
 		{value: fmt.Sprintf("%d", math.MinInt), want: false},
+		{value: json.Number("18446744073709551615"), want: true, wantValue: uint64(math.MaxUint64)},
+		{value: json.Number("18446744073709551616"), want: false},
+		{value: json.Number("-1"), want: false},
 		{value: 2.0, want: true, wantValue: uint64(2)},
 		{value: float32(2.0), want: true, wantValue: uint64(2)},
 		{value: "2", want: true, wantValue: uint64(2)},
@@ -87,3 +87,38 @@ func (v *IPv6Validator) Name() string { return "ipv6" }
 func IPv6() *IPv6Validator {
 	return &IPv6Validator{}
 }
+
+//------------------------------
+
+// IPPublicValidator the field under validation must be a string representing
+// a valid public IPv4 or IPv6. See `IsPublicIP()`.
+// If validation passes, the value is converted to `net.IP`.
+type IPPublicValidator struct{ IPValidator }
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *IPPublicValidator) Validate(ctx *Context) bool {
+	if !v.IPValidator.Validate(ctx) {
+		return false
+	}
+	return IsPublicIP(ctx.Value.(net.IP))
+}
+
+// IPPublic the field under validation must be a string representing a valid public IPv4 or IPv6.
+// Private (RFC 1918), loopback, link-local, unique-local (RFC 4193) and unspecified
+// addresses are rejected. This is useful to prevent server-side request forgery (SSRF).
+// If validation passes, the value is converted to `net.IP`.
+func IPPublic() *IPPublicValidator {
+	return &IPPublicValidator{}
+}
+
+// IsPublicIP returns false if the given IP is a private (RFC 1918), loopback,
+// link-local, unique-local (RFC 4193) or unspecified address.
+// IPv4-mapped IPv6 addresses are checked as IPv4.
+func IsPublicIP(ip net.IP) bool {
+	return !ip.IsPrivate() &&
+		!ip.IsLoopback() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsUnspecified()
+}
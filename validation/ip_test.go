@@ -160,3 +160,54 @@ func TestIPv6Validator(t *testing.T) {
 		})
 	}
 }
+
+func TestIPPublicValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := IPPublic()
+		assert.NotNil(t, v)
+		assert.Equal(t, "ip", v.Name())
+		assert.True(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+	})
+
+	cases := []struct {
+		value     any
+		wantValue net.IP
+		want      bool
+	}{
+		{value: "88.88.88.88", want: true, wantValue: net.ParseIP("88.88.88.88")},
+		{value: net.ParseIP("88.88.88.88"), want: true, wantValue: net.ParseIP("88.88.88.88")},
+		{value: "2001:4860:4860::8888", want: true, wantValue: net.ParseIP("2001:4860:4860::8888")},
+		{value: "127.0.0.1", want: false},
+		{value: "127.1.2.3", want: false},
+		{value: net.ParseIP("127.0.0.1"), want: false},
+		{value: "::1", want: false},
+		{value: "10.0.0.5", want: false},
+		{value: "172.16.3.4", want: false},
+		{value: "192.168.0.1", want: false},
+		{value: "169.254.169.254", want: false},
+		{value: "fe80::1", want: false},
+		{value: "fd12:3456:789a::1", want: false},
+		{value: "::ffff:10.0.0.5", want: false},
+		{value: "0.0.0.0", want: false},
+		{value: "::", want: false},
+		{value: "256.0.0.1", want: false},
+		{value: "string", want: false},
+		{value: 2, want: false},
+		{value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%t", c.value, c.want), func(t *testing.T) {
+			v := IPPublic()
+			ctx := &Context{
+				Value: c.value,
+			}
+			ok := v.Validate(ctx)
+			if assert.Equal(t, c.want, ok) && ok {
+				assert.Equal(t, c.wantValue, ctx.Value)
+			}
+		})
+	}
+}
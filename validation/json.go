@@ -1,6 +1,10 @@
 package validation
 
-import "encoding/json"
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
 
 // JSONValidator validates the field under validation must be a valid JSON string.
 type JSONValidator struct{ BaseValidator }
@@ -30,3 +34,45 @@ func (v *JSONValidator) IsType() bool { return true }
 func JSON() *JSONValidator {
 	return &JSONValidator{}
 }
+
+//------------------------------
+
+// CanonicalJSONValidator validates the field under validation must be a valid JSON
+// string in canonical form: object keys are sorted and there is no insignificant whitespace.
+// This is useful when the raw string is signed. Numbers and escaped characters
+// are kept as written, so they must not be re-encoded differently by the client.
+// Unlike `JSON()`, the field value is not modified.
+type CanonicalJSONValidator struct{ BaseValidator }
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *CanonicalJSONValidator) Validate(ctx *Context) bool {
+	str, ok := ctx.Value.(string)
+	if !ok || !json.Valid([]byte(str)) {
+		return false
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(str))
+	decoder.UseNumber()
+	var data any
+	if err := decoder.Decode(&data); err != nil {
+		return false
+	}
+
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(data); err != nil {
+		return false
+	}
+	return bytes.Equal(bytes.TrimSuffix(buf.Bytes(), []byte{'\n'}), []byte(str))
+}
+
+// Name returns the string name of the validator.
+func (v *CanonicalJSONValidator) Name() string { return "canonical_json" }
+
+// CanonicalJSON the field under validation must be a valid JSON string in canonical
+// form: object keys are sorted and there is no insignificant whitespace.
+// This is useful when the raw string is signed. Unlike `JSON()`, the field value is not modified.
+func CanonicalJSON() *CanonicalJSONValidator {
+	return &CanonicalJSONValidator{}
+}
@@ -51,3 +51,47 @@ func TestJSONValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestCanonicalJSONValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := CanonicalJSON()
+		assert.NotNil(t, v)
+		assert.Equal(t, "canonical_json", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+	})
+
+	cases := []struct {
+		value any
+		want  bool
+	}{
+		{value: `{"a":1,"b":[true,null,"c"],"c":{"d":1.50,"e":"<&>"}}`, want: true},
+		{value: `["b","a"]`, want: true},
+		{value: `"string"`, want: true},
+		{value: `12345678901234567890`, want: true},
+		{value: `{"b":1,"a":2}`, want: false},
+		{value: `{"a":{"c":1,"b":2}}`, want: false},
+		{value: `{"a": 1}`, want: false},
+		{value: ` {"a":1}`, want: false},
+		{value: "{\"a\":1}\n", want: false},
+		{value: `{"a":1}{"b":2}`, want: false},
+		{value: `{"a":"\u0041"}`, want: false},
+		{value: `{"a":1`, want: false},
+		{value: "", want: false},
+		{value: 2, want: false},
+		{value: []byte(`{"a":1}`), want: false},
+		{value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%t", c.value, c.want), func(t *testing.T) {
+			v := CanonicalJSON()
+			ctx := &Context{
+				Value: c.value,
+			}
+			assert.Equal(t, c.want, v.Validate(ctx))
+			assert.Equal(t, c.value, ctx.Value)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"goyave.dev/goyave/v5/util/errors"
+)
+
+// ForMethod scopes the given validators to the requests using the given HTTP method
+// (case-insensitive). The validators are only executed if `Context.Method` matches.
+// This is useful to share a rule set between the creation (POST) and the update (PATCH)
+// of a resource, for example if fields are required on creation but optional on update:
+//
+//	{Path: "name", Rules: append(v.ForMethod(http.MethodPost, v.Required()), v.String(), v.Max(255))},
+//
+// `Required()`, `RequiredIf()` and `RequiredIfMatches()` are converted to a `RequiredIf()` validator
+// whose condition also checks the method. The other validators are wrapped in `OnlyIf()`.
+// The method is set in `Options.Method` (automatically when validating a request). If the data
+// doesn't come from a request, the method is empty and the scoped validators are never executed.
+//
+// Panics if one of the given validators is `Nullable()` or a type validator, as they change how
+// the validation engine processes the field.
+func ForMethod(method string, validators ...Validator) List {
+	isMethod := func(ctx *Context) bool {
+		return strings.EqualFold(ctx.Method, method)
+	}
+	list := make(List, 0, len(validators))
+	for _, v := range validators {
+		switch v := v.(type) {
+		case *RequiredValidator:
+			list = append(list, &RequiredIfValidator{Condition: isMethod, RequiredValidator: *v})
+		case *RequiredIfValidator:
+			list = append(list, &RequiredIfValidator{Condition: andCondition(isMethod, v.Condition), RequiredValidator: v.RequiredValidator})
+		case *RequiredIfMatchesValidator:
+			scoped := *v
+			scoped.Condition = andCondition(isMethod, v.Condition)
+			list = append(list, &scoped)
+		case *NullableValidator:
+			panic(errors.NewSkip("validation.ForMethod: nullable validator cannot be scoped to a method", 3))
+		default:
+			if v.IsType() {
+				panic(errors.NewSkip(fmt.Errorf("validation.ForMethod: type validator %q cannot be scoped to a method", v.Name()), 3))
+			}
+			list = append(list, OnlyIf(isMethod, v))
+		}
+	}
+	return list
+}
+
+func andCondition(a, b func(*Context) bool) func(*Context) bool {
+	return func(ctx *Context) bool {
+		return a(ctx) && b(ctx)
+	}
+}
@@ -0,0 +1,123 @@
+package validation
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goyave.dev/goyave/v5/lang"
+)
+
+func TestForMethod(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		list := ForMethod(http.MethodPost, Required(), RequiredNotEmpty(), RequiredIf(alwaysTrue), RequiredIfMatches("other", "^a$"), Max(3))
+		require.Len(t, list, 5)
+
+		post := &Context{Method: http.MethodPost}
+		patch := &Context{Method: http.MethodPatch}
+
+		required, ok := list[0].(*RequiredIfValidator)
+		require.True(t, ok)
+		assert.Equal(t, "required", required.Name())
+		assert.True(t, required.Condition(post))
+		assert.True(t, required.Condition(&Context{Method: "post"}))
+		assert.False(t, required.Condition(patch))
+		assert.False(t, required.Condition(&Context{}))
+
+		requiredNotEmpty, ok := list[1].(*RequiredIfValidator)
+		require.True(t, ok)
+		assert.True(t, requiredNotEmpty.NotEmpty)
+
+		requiredIf, ok := list[2].(*RequiredIfValidator)
+		require.True(t, ok)
+		assert.True(t, requiredIf.Condition(post))
+		assert.False(t, requiredIf.Condition(patch))
+
+		requiredIfMatches, ok := list[3].(*RequiredIfMatchesValidator)
+		require.True(t, ok)
+		assert.Equal(t, "required_if", requiredIfMatches.Name())
+		data := map[string]any{"other": "a"}
+		assert.True(t, requiredIfMatches.Condition(&Context{Method: http.MethodPost, Data: data}))
+		assert.False(t, requiredIfMatches.Condition(&Context{Method: http.MethodPatch, Data: data}))
+		assert.False(t, requiredIfMatches.Condition(&Context{Method: http.MethodPost, Data: map[string]any{"other": "b"}}))
+
+		onlyIf, ok := list[4].(*OnlyIfValidator)
+		require.True(t, ok)
+		assert.Equal(t, "max", onlyIf.Name())
+		assert.True(t, onlyIf.Condition(post))
+		assert.False(t, onlyIf.Condition(patch))
+
+		assert.Panics(t, func() {
+			ForMethod(http.MethodPost, Nullable())
+		})
+		assert.Panics(t, func() {
+			ForMethod(http.MethodPost, String())
+		})
+	})
+
+	ruleSet := RuleSet{
+		{Path: CurrentElement, Rules: List{Object()}},
+		{Path: "name", Rules: append(ForMethod(http.MethodPost, Required()), String(), Max(10))},
+		{Path: "email", Rules: append(List{String()}, ForMethod(http.MethodPatch, Max(5))...)},
+	}
+
+	cases := []struct {
+		data   map[string]any
+		want   map[string][]string
+		desc   string
+		method string
+	}{
+		{desc: "post_missing", method: http.MethodPost, data: map[string]any{}, want: map[string][]string{"name": {"The name is required.", "The name must be a string."}}},
+		{desc: "post_present", method: http.MethodPost, data: map[string]any{"name": "John"}},
+		{desc: "patch_missing", method: http.MethodPatch, data: map[string]any{}},
+		{desc: "patch_present", method: http.MethodPatch, data: map[string]any{"name": "John"}},
+		{desc: "no_method", method: "", data: map[string]any{}},
+		{desc: "post_other_validator", method: http.MethodPost, data: map[string]any{"name": "John", "email": "john@example.org"}},
+		{desc: "patch_other_validator", method: http.MethodPatch, data: map[string]any{"email": "john@example.org"}, want: map[string][]string{"email": {"The email address may not have more than 5 characters."}}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			errs, err := Validate(&Options{
+				Data:     c.data,
+				Rules:    ruleSet,
+				Language: lang.New().GetDefault(),
+				Method:   c.method,
+			})
+			require.Empty(t, err)
+			if c.want == nil {
+				assert.Nil(t, errs)
+				return
+			}
+			require.NotNil(t, errs)
+			for field, messages := range c.want {
+				require.Contains(t, errs.Fields, field)
+				assert.Equal(t, messages, errs.Fields[field].Errors)
+			}
+		})
+	}
+
+	t.Run("context_method", func(t *testing.T) {
+		var method string
+		var requiredMethod string
+		_, err := Validate(&Options{
+			Data: map[string]any{"name": "John"},
+			Rules: RuleSet{
+				{Path: "name", Rules: List{&testValidator{validateFunc: func(_ component, ctx *Context) bool {
+					method = ctx.Method
+					return true
+				}}}},
+				{Path: "missing", Rules: List{RequiredIf(func(ctx *Context) bool {
+					requiredMethod = ctx.Method
+					return false
+				})}},
+			},
+			Language: lang.New().GetDefault(),
+			Method:   http.MethodPut,
+		})
+		require.Empty(t, err)
+		assert.Equal(t, http.MethodPut, method)
+		assert.Equal(t, http.MethodPut, requiredMethod)
+	})
+}
@@ -1,9 +1,19 @@
 package validation
 
 import (
+	"fmt"
+	"image"
+	"io"
+	"strconv"
 	"strings"
 
+	// Register the standard image decoders for `ValidImage()`.
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+
 	"github.com/samber/lo"
+	"goyave.dev/goyave/v5/util/errors"
 	"goyave.dev/goyave/v5/util/fsutil"
 )
 
@@ -70,3 +80,126 @@ func (v *ImageValidator) Name() string { return "image" }
 func Image() *ImageValidator {
 	return &ImageValidator{MIMEValidator: MIMEValidator{MIMETypes: ImageMIMETypes}}
 }
+
+//------------------------------
+
+// ValidImageValidator validates the field under validation must be a file that can
+// be fully decoded as an image using `image.Decode()`. Truncated or corrupt images,
+// even with a valid header, don't pass.
+// Multi-files are supported (all files must satisfy the criteria).
+type ValidImageValidator struct {
+	BaseValidator
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *ValidImageValidator) Validate(ctx *Context) bool {
+	files, ok := ctx.Value.([]fsutil.File)
+	if !ok {
+		return false
+	}
+	for _, file := range files {
+		ok := readFile(file, func(r io.Reader) bool {
+			_, _, err := image.Decode(r)
+			return err == nil
+		})
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// readFile opens the given uploaded file and returns the result of "fn".
+// Returns false if the file cannot be opened.
+func readFile(file fsutil.File, fn func(r io.Reader) bool) bool {
+	if file.Header == nil {
+		return false
+	}
+	f, err := file.Header.Open()
+	if err != nil {
+		return false
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	return fn(f)
+}
+
+// Name returns the string name of the validator.
+func (v *ValidImageValidator) Name() string { return "valid_image" }
+
+// ValidImage the field under validation must be a file that can be fully decoded as an image
+// using `image.Decode()`. Unlike `Image()`, which only checks the MIME type detected from
+// the first bytes of the file, this validator rejects truncated or corrupt images.
+// Multi-files are supported (all files must satisfy the criteria).
+//
+// The JPEG, PNG and GIF formats are supported. Other formats can be supported by
+// registering their decoder with `image.RegisterFormat()` (usually by importing the
+// decoder package, for example `golang.org/x/image/webp`).
+//
+// Decoding reads the entire file and allocates memory proportional to the image
+// dimensions, which is expensive. Place this validator after cheaper validators
+// such as `Image()` and `Max()` so it is only executed on reasonably sized images.
+func ValidImage() *ValidImageValidator {
+	return &ValidImageValidator{}
+}
+
+//------------------------------
+
+// ImageMaxPixelsValidator validates the field under validation must be an image file
+// whose number of pixels (width × height) doesn't exceed `Max`.
+// Only the image header is decoded using `image.DecodeConfig()`.
+// Multi-files are supported (all files must satisfy the criteria).
+type ImageMaxPixelsValidator struct {
+	BaseValidator
+	Max int64
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *ImageMaxPixelsValidator) Validate(ctx *Context) bool {
+	files, ok := ctx.Value.([]fsutil.File)
+	if !ok {
+		return false
+	}
+	for _, file := range files {
+		ok := readFile(file, func(r io.Reader) bool {
+			config, _, err := image.DecodeConfig(r)
+			if err != nil || config.Width < 0 || config.Height < 0 {
+				return false
+			}
+			// Compare using a division to avoid overflows.
+			return config.Width == 0 || int64(config.Height) <= v.Max/int64(config.Width)
+		})
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Name returns the string name of the validator.
+func (v *ImageMaxPixelsValidator) Name() string { return "image_max_pixels" }
+
+// MessagePlaceholders returns the ":max" placeholder.
+func (v *ImageMaxPixelsValidator) MessagePlaceholders(_ *Context) []string {
+	return []string{
+		":max", strconv.FormatInt(v.Max, 10),
+	}
+}
+
+// ImageMaxPixels the field under validation must be an image file whose number of pixels
+// (width × height) doesn't exceed the given maximum. Multi-files are supported
+// (all files must satisfy the criteria). Supported formats are the same as `ValidImage()`.
+//
+// Only the image header is read (using `image.DecodeConfig()`), so this validator is cheap.
+// Place it before `ValidImage()` or before decoding the image in your handler to protect
+// against decompression bombs: small files declaring huge dimensions that would
+// exhaust the memory when decoded.
+//
+// Panics if "max" is lower than 1.
+func ImageMaxPixels(max int64) *ImageMaxPixelsValidator {
+	if max < 1 {
+		panic(errors.NewSkip(fmt.Errorf("validation.ImageMaxPixels: max must be greater than 0, %d given", max), 3))
+	}
+	return &ImageMaxPixelsValidator{Max: max}
+}
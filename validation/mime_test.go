@@ -1,10 +1,19 @@
 package validation
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"math"
+	"mime/multipart"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"goyave.dev/goyave/v5/util/fsutil"
 )
 
@@ -89,3 +98,124 @@ func TestImageValidator(t *testing.T) {
 		})
 	}
 }
+
+// createTestFiles creates files from multipart form data, as if they were received in a request.
+func createTestFiles(t *testing.T, contents ...[]byte) []fsutil.File {
+	t.Helper()
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for i, content := range contents {
+		part, err := writer.CreateFormFile("file", fmt.Sprintf("file_%d", i))
+		require.NoError(t, err)
+		_, err = part.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(math.MaxInt64 - 1)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = form.RemoveAll()
+	})
+	files, err := fsutil.ParseMultipartFiles(form.File["file"])
+	require.NoError(t, err)
+	return files
+}
+
+func TestValidImageValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := ValidImage()
+		assert.NotNil(t, v)
+		assert.Equal(t, "valid_image", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+	})
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, jpeg.Encode(buf, image.NewRGBA(image.Rect(0, 0, 64, 64)), nil))
+	validJPEG := buf.Bytes()
+	truncatedJPEG := validJPEG[:len(validJPEG)/2]
+
+	cases := []struct {
+		value any
+		desc  string
+		want  bool
+	}{
+		{desc: "valid_jpeg", value: createTestFiles(t, validJPEG), want: true},
+		{desc: "multiple_valid", value: createTestFiles(t, validJPEG, validJPEG), want: true},
+		{desc: "truncated_jpeg", value: createTestFiles(t, truncatedJPEG), want: false},
+		{desc: "one_truncated", value: createTestFiles(t, validJPEG, truncatedJPEG), want: false},
+		{desc: "not_an_image", value: createTestFiles(t, []byte("this is not an image")), want: false},
+		{desc: "no_header", value: []fsutil.File{{MIMEType: "image/jpeg"}}, want: false},
+		{desc: "not_a_file", value: "string", want: false},
+		{desc: "nil", value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			v := ValidImage()
+			assert.Equal(t, c.want, v.Validate(&Context{Value: c.value}))
+		})
+	}
+
+	t.Run("mime_type_is_not_enough", func(t *testing.T) {
+		files := createTestFiles(t, truncatedJPEG)
+		assert.True(t, Image().Validate(&Context{Value: files}))
+		assert.False(t, ValidImage().Validate(&Context{Value: files}))
+	})
+}
+
+func TestImageMaxPixelsValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := ImageMaxPixels(100)
+		assert.NotNil(t, v)
+		assert.Equal(t, "image_max_pixels", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Equal(t, []string{":max", "100"}, v.MessagePlaceholders(&Context{}))
+		assert.Equal(t, int64(100), v.Max)
+
+		assert.Panics(t, func() {
+			ImageMaxPixels(0)
+		})
+	})
+
+	encodePNG := func(width, height int) []byte {
+		buf := &bytes.Buffer{}
+		require.NoError(t, png.Encode(buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+		return buf.Bytes()
+	}
+	small := encodePNG(10, 10)
+	large := encodePNG(200, 100)
+
+	// A tiny PNG declaring huge dimensions in its header. It would exhaust the memory if fully decoded.
+	bomb := encodePNG(1, 1)
+	ihdr := bomb[12:29] // Chunk type + data
+	binary.BigEndian.PutUint32(ihdr[4:8], 1<<20)
+	binary.BigEndian.PutUint32(ihdr[8:12], 1<<20)
+	binary.BigEndian.PutUint32(bomb[29:33], crc32.ChecksumIEEE(ihdr))
+
+	cases := []struct {
+		value any
+		desc  string
+		want  bool
+	}{
+		{desc: "under_cap", value: createTestFiles(t, small), want: true},
+		{desc: "exactly_cap", value: createTestFiles(t, encodePNG(100, 100)), want: true},
+		{desc: "over_cap", value: createTestFiles(t, large), want: false},
+		{desc: "one_over_cap", value: createTestFiles(t, small, large), want: false},
+		{desc: "bomb", value: createTestFiles(t, bomb), want: false},
+		{desc: "not_an_image", value: createTestFiles(t, []byte("this is not an image")), want: false},
+		{desc: "no_header", value: []fsutil.File{{MIMEType: "image/png"}}, want: false},
+		{desc: "not_a_file", value: "string", want: false},
+		{desc: "nil", value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			v := ImageMaxPixels(10000)
+			assert.Equal(t, c.want, v.Validate(&Context{Value: c.value}))
+		})
+	}
+}
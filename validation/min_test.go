@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"mime/multipart"
@@ -35,6 +36,9 @@ func TestMinalidator(t *testing.T) {
 		{value: float64(math.MinInt64), want: true, min: math.MinInt64},
 		{value: int64(math.MaxInt64), want: false, min: math.MinInt64}, // Don't pass because above max int value that can accurately fit in float64
 		{value: int64(math.MinInt64), want: false, min: math.MinInt64}, // Don't pass because below min int value that can accurately fit in float64
+		{value: json.Number("2.5"), want: false, min: 3},
+		{value: json.Number("3"), want: true, min: 3},
+		{value: json.Number("abc"), want: false, min: 0}, // Not a valid number, not validated as a string
 		{value: 'a', want: false, min: 100},
 		{value: "abc", want: false, min: 4},
 		{value: []string{"a", "b"}, want: false, min: 3},
@@ -0,0 +1,67 @@
+package validation
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoneyAmountValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := MoneyAmount()
+		assert.NotNil(t, v)
+		assert.Equal(t, "money", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+	})
+
+	cases := []struct {
+		value any
+		want  bool
+	}{
+		{value: "USD 12.34", want: true},
+		{value: "USD 12.3", want: true},
+		{value: "USD 12", want: true},
+		{value: "USD -12.34", want: true},
+		{value: "JPY 1200", want: true},
+		{value: "KWD 1.234", want: true},
+		{value: "USD 12.345", want: false},
+		{value: "JPY 12.5", want: false},
+		{value: "JPY 12.", want: false},
+		{value: "KWD 1.2345", want: false},
+		{value: "XYZ 12.34", want: false},
+		{value: "usd 12.34", want: false},
+		{value: "USD", want: false},
+		{value: "USD ", want: false},
+		{value: "USD  12.34", want: false},
+		{value: "USD 1,000.00", want: false},
+		{value: "USD 1e3", want: false},
+		{value: "USD .5", want: false},
+		{value: "12.34 USD", want: false},
+		{value: map[string]any{"amount": "12.34", "currency": "USD"}, want: true},
+		{value: map[string]any{"amount": 12.34, "currency": "EUR"}, want: true},
+		{value: map[string]any{"amount": 1200, "currency": "JPY"}, want: true},
+		{value: map[string]any{"amount": int64(1200), "currency": "JPY"}, want: true},
+		{value: map[string]any{"amount": 12.5, "currency": "JPY"}, want: false},
+		{value: map[string]any{"amount": "12.345", "currency": "USD"}, want: false},
+		{value: map[string]any{"amount": math.NaN(), "currency": "USD"}, want: false},
+		{value: map[string]any{"amount": "12.34", "currency": "XYZ"}, want: false},
+		{value: map[string]any{"amount": "12.34"}, want: false},
+		{value: map[string]any{"currency": "USD"}, want: false},
+		{value: map[string]any{"amount": true, "currency": "USD"}, want: false},
+		{value: map[string]any{"amount": "12.34", "currency": 840}, want: false},
+		{value: 12.34, want: false},
+		{value: []string{"USD", "12.34"}, want: false},
+		{value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%t", c.value, c.want), func(t *testing.T) {
+			v := MoneyAmount()
+			assert.Equal(t, c.want, v.Validate(&Context{Value: c.value}))
+		})
+	}
+}
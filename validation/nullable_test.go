@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"goyave.dev/goyave/v5/lang"
 )
 
 func TestNullableValidator(t *testing.T) {
@@ -44,3 +45,41 @@ func TestNullableValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestNullableValidatorEngine(t *testing.T) {
+	cases := []struct {
+		value any
+		want  *Errors
+		desc  string
+	}{
+		{desc: "null_skips_validators", value: nil, want: nil},
+		{desc: "present_runs_validators", value: "a", want: &Errors{Fields: FieldsErrors{"field": {Errors: []string{"The field must be at least 3 characters."}}}}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			called := false
+			data := map[string]any{"field": c.value}
+			validationErrors, errs := Validate(&Options{
+				Data: data,
+				Rules: RuleSet{
+					{Path: "field", Rules: List{
+						Required(),
+						Nullable(),
+						&testValidator{validateFunc: func(_ component, _ *Context) bool {
+							called = true
+							return true
+						}},
+						String(),
+						Min(3),
+					}},
+				},
+				Language: lang.Default,
+			})
+			assert.Empty(t, errs)
+			assert.Equal(t, c.want, validationErrors)
+			assert.Equal(t, c.value != nil, called)
+			assert.Contains(t, data, "field")
+		})
+	}
+}
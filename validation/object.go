@@ -36,6 +36,8 @@ func (v *ObjectValidator) IsType() bool { return true }
 // Object the field under validation must be an object (`map[string]any`).
 // If the value of the field under validation is a valid JSON string that can be unmarshalled
 // into a `map[string]any`, converts the value to `map[string]any`.
+//
+// This validator also marks the field as an object for the validation engine (see `Field.IsObject()`).
 func Object() *ObjectValidator {
 	return &ObjectValidator{}
 }
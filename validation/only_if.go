@@ -26,7 +26,7 @@ func (v *OnlyIfValidator) Validate(ctx *Context) bool {
 //		  return other.Value == true
 //	  }, MyValidator())
 //
-// This CANNOT be used with `Required()`, `RequiredIf()`, `Nullable()` or any type validator.
+// This CANNOT be used with `Required()`, `RequiredIf()`, `RequiredIfMatches()`, `Nullable()` or any type validator.
 func OnlyIf(condition func(*Context) bool, validator Validator) *OnlyIfValidator {
 	return &OnlyIfValidator{
 		Validator: validator,
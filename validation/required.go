@@ -1,5 +1,14 @@
 package validation
 
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+
+	"goyave.dev/goyave/v5/util/errors"
+	"goyave.dev/goyave/v5/util/walk"
+)
+
 // RequiredValidator the field under validation is required.
 // If a field is absent from the input data, subsequent validators
 // will not be executed.
@@ -7,16 +16,36 @@ package validation
 // If a field is `nil` and has the `Nullable` validator, this validator passes.
 // As non-nullable fields are removed if they have a `nil` value, this validator
 // doesn't pass if a field is `nil` and doesn't have the `Nullable` validator.
-type RequiredValidator struct{ BaseValidator }
+//
+// If `NotEmpty` is true, empty strings, empty slices (including empty file slices) and
+// empty objects are considered absent as well and don't pass.
+type RequiredValidator struct {
+	BaseValidator
+	NotEmpty bool
+}
 
 // Validate checks the field under validation satisfies this validator's criteria.
 func (v *RequiredValidator) Validate(ctx *Context) bool {
 	if !ctx.Field.IsNullable() && ctx.Value == nil {
 		return false
 	}
+	if v.NotEmpty && ctx.Value != nil {
+		return !isEmpty(ctx.Value)
+	}
 	return true
 }
 
+// isEmpty returns true if the given value is an empty string, an empty slice
+// or an empty map.
+func isEmpty(value any) bool {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+	return false
+}
+
 // Name returns the string name of the validator.
 func (v *RequiredValidator) Name() string { return "required" }
 
@@ -27,10 +56,19 @@ func (v *RequiredValidator) Name() string { return "required" }
 // If a field is `nil` and has the `Nullable` validator, this validator passes.
 // As non-nullable fields are removed if they have a `nil` value, this validator
 // doesn't pass if a field is `nil` and doesn't have the `Nullable` validator.
+//
+// Only the presence of the field is checked: empty strings, empty slices and zero values pass.
+// Use `RequiredNotEmpty()` to reject empty values as well.
 func Required() *RequiredValidator {
 	return &RequiredValidator{}
 }
 
+// RequiredNotEmpty is the same as `Required` but also doesn't pass if the field under validation
+// is an empty string, an empty slice (including empty file slices) or an empty object.
+func RequiredNotEmpty() *RequiredValidator {
+	return &RequiredValidator{NotEmpty: true}
+}
+
 //------------------------------
 
 // RequiredIfValidator is the same as `RequiredValidator` but only applies the behavior
@@ -53,3 +91,68 @@ func (v *RequiredIfValidator) Validate(ctx *Context) bool {
 func RequiredIf(condition func(*Context) bool) *RequiredIfValidator {
 	return &RequiredIfValidator{Condition: condition}
 }
+
+//------------------------------
+
+// RequiredIfMatchesValidator is the same as `RequiredValidator` but only applies the behavior
+// described if the value of the field identified by the given path matches the given pattern.
+type RequiredIfMatchesValidator struct {
+	Path    *walk.Path
+	Pattern *regexp.Regexp
+	RequiredIfValidator
+}
+
+// Name returns the string name of the validator.
+func (v *RequiredIfMatchesValidator) Name() string { return "required_if" }
+
+// MessagePlaceholders returns the ":other" placeholder.
+func (v *RequiredIfMatchesValidator) MessagePlaceholders(_ *Context) []string {
+	return []string{
+		":other", GetFieldName(v.Lang(), v.Path),
+	}
+}
+
+func (v *RequiredIfMatchesValidator) matches(ctx *Context) bool {
+	other := v.Path.First(ctx.Data)
+	if other.Found != walk.Found {
+		return false
+	}
+	switch value := other.Value.(type) {
+	case string:
+		return v.Pattern.MatchString(value)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, bool:
+		return v.Pattern.MatchString(fmt.Sprint(value))
+	}
+	return false
+}
+
+// RequiredIfMatches is the same as `Required` but only applies the behavior described if the
+// value of the field identified by the given path matches the given regular expression.
+// Numbers and booleans are formatted with `fmt.Sprint` before being matched. If the other field
+// is absent or has another type, the field under validation is not required.
+// If the path matches several fields (wildcards), only the first one is checked.
+//
+// Panics if the path cannot be parsed or if the pattern is not a valid regular expression.
+func RequiredIfMatches(path, pattern string) *RequiredIfMatchesValidator {
+	v, err := RequiredIfMatchesE(path, pattern)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// RequiredIfMatchesE is the same as `RequiredIfMatches()` but returns an error instead of panicking
+// if the given path cannot be parsed or if the pattern is invalid.
+func RequiredIfMatchesE(path, pattern string) (*RequiredIfMatchesValidator, error) {
+	p, err := walk.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("validation.RequiredIfMatches: path parse error: %w", err)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("validation.RequiredIfMatches: invalid pattern: %w", err)
+	}
+	v := &RequiredIfMatchesValidator{Path: p, Pattern: re}
+	v.Condition = v.matches
+	return v, nil
+}
@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goyave.dev/goyave/v5/lang"
+	"goyave.dev/goyave/v5/util/fsutil"
 )
 
 func TestRequiredValidator(t *testing.T) {
@@ -49,6 +52,109 @@ func TestRequiredValidator(t *testing.T) {
 	}
 }
 
+func TestRequiredNotEmptyValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := RequiredNotEmpty()
+		assert.NotNil(t, v)
+		assert.Equal(t, "required", v.Name())
+		assert.True(t, v.NotEmpty)
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+	})
+
+	cases := []struct {
+		value    any
+		nullable bool
+		want     bool
+	}{
+		{value: "string", want: true},
+		{value: "", want: false},
+		{value: 'a', want: true},
+		{value: 0, want: true},
+		{value: 2.5, want: true},
+		{value: false, want: true},
+		{value: []string{"string"}, want: true},
+		{value: []string{}, want: false},
+		{value: []any{}, want: false},
+		{value: []fsutil.File{}, want: false},
+		{value: []fsutil.File{{}}, want: true},
+		{value: map[string]any{"a": 1}, want: true},
+		{value: map[string]any{}, want: false},
+		{value: nil, want: false},
+		{value: nil, want: true, nullable: true},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%t", c.value, c.want), func(t *testing.T) {
+			v := RequiredNotEmpty()
+			ctx := &Context{
+				Value: c.value,
+				Field: &Field{
+					isNullable: c.nullable,
+				},
+			}
+
+			assert.Equal(t, c.want, v.Validate(ctx))
+		})
+	}
+
+	t.Run("absent_field_skips_validators", func(t *testing.T) {
+		validationErrors, errs := Validate(&Options{
+			Data: map[string]any{"required": ""},
+			Rules: RuleSet{
+				{Path: "required", Rules: List{RequiredNotEmpty(), String()}},
+				{Path: "optional", Rules: List{String(), Min(3)}},
+			},
+			Language: lang.Default,
+		})
+		assert.Empty(t, errs)
+		want := &Errors{Fields: FieldsErrors{"required": {Errors: []string{"The required is required."}}}}
+		assert.Equal(t, want, validationErrors)
+	})
+
+	t.Run("presence_of_zero_values", func(t *testing.T) {
+		// `Required` only checks the field is present: empty strings and zero values pass.
+		// `RequiredNotEmpty` also rejects empty values. A missing key fails both.
+		rules := RuleSet{
+			{Path: "present", Rules: List{Required()}},
+			{Path: "not_empty", Rules: List{RequiredNotEmpty()}},
+			{Path: "zero", Rules: List{Required(), Int()}},
+		}
+		cases := []struct {
+			data map[string]any
+			want *Errors
+			desc string
+		}{
+			{
+				desc: "empty_string",
+				data: map[string]any{"present": "", "not_empty": "", "zero": 0},
+				want: &Errors{Fields: FieldsErrors{"not_empty": {Errors: []string{"The not_empty is required."}}}},
+			},
+			{
+				desc: "missing",
+				data: map[string]any{},
+				want: &Errors{Fields: FieldsErrors{
+					"present":   {Errors: []string{"The present is required."}},
+					"not_empty": {Errors: []string{"The not_empty is required."}},
+					"zero":      {Errors: []string{"The zero is required.", "The zero must be an integer."}},
+				}},
+			},
+		}
+		for _, c := range cases {
+			t.Run(c.desc, func(t *testing.T) {
+				validationErrors, errs := Validate(&Options{
+					Data:     c.data,
+					Rules:    rules,
+					Language: lang.Default,
+				})
+				assert.Empty(t, errs)
+				assert.Equal(t, c.want, validationErrors)
+			})
+		}
+	})
+}
+
 func TestRequiredIfValidator(t *testing.T) {
 	alwaysRequired := func(_ *Context) bool { return true }
 	t.Run("Constructor", func(t *testing.T) {
@@ -95,3 +201,94 @@ func TestRequiredIfValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestRequiredIfMatchesValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := RequiredIfMatches("object.field", "^a+$")
+		v.lang = &lang.Language{}
+		assert.NotNil(t, v)
+		assert.Equal(t, "required_if", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Equal(t, []string{":other", "field"}, v.MessagePlaceholders(&Context{}))
+		assert.Equal(t, "^a+$", v.Pattern.String())
+
+		assert.Panics(t, func() {
+			RequiredIfMatches("invalid[path.", "^a+$")
+		})
+		assert.Panics(t, func() {
+			RequiredIfMatches("object.field", "[")
+		})
+
+		v2, err := RequiredIfMatchesE("object.field", "^a+$")
+		require.NoError(t, err)
+		assert.Equal(t, "object.field", v2.Path.String())
+		v2, err = RequiredIfMatchesE("invalid[path.", "^a+$")
+		require.Error(t, err)
+		assert.Nil(t, v2)
+		v2, err = RequiredIfMatchesE("object.field", "[")
+		require.Error(t, err)
+		assert.Nil(t, v2)
+
+		assert.Panics(t, func() {
+			Warn(RequiredIfMatches("object.field", "^a+$"))
+		})
+	})
+
+	cases := []struct {
+		other any
+		value any
+		desc  string
+		want  bool
+	}{
+		{desc: "matches_present", other: "business", value: "ACME", want: true},
+		{desc: "matches_absent", other: "business", value: nil, want: false},
+		{desc: "doesnt_match_absent", other: "personal", value: nil, want: true},
+		{desc: "number_matches_absent", other: 42, value: nil, want: false},
+		{desc: "number_doesnt_match_absent", other: 4.5, value: nil, want: true},
+		{desc: "other_absent", other: nil, value: nil, want: true},
+		{desc: "other_not_scalar", other: []string{"business"}, value: nil, want: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			v := RequiredIfMatches("type", `^(business|\d+)$`)
+			data := map[string]any{}
+			if c.other != nil {
+				data["type"] = c.other
+			}
+			ctx := &Context{
+				Data:  data,
+				Value: c.value,
+				Field: &Field{},
+			}
+			assert.Equal(t, c.want, v.Validate(ctx))
+		})
+	}
+
+	t.Run("Validate", func(t *testing.T) {
+		rules := func() RuleSet {
+			return RuleSet{
+				{Path: "type", Rules: List{Required(), String()}},
+				{Path: "company", Rules: List{RequiredIfMatches("type", "^business"), String()}},
+			}
+		}
+
+		validationErrors, errs := Validate(&Options{
+			Data:     map[string]any{"type": "business-premium"},
+			Rules:    rules(),
+			Language: lang.New().GetDefault(),
+		})
+		require.Empty(t, errs)
+		require.NotNil(t, validationErrors)
+		assert.Equal(t, []string{"The company is required because of the value of the type.", "The company must be a string."}, validationErrors.Fields["company"].Errors)
+
+		validationErrors, errs = Validate(&Options{
+			Data:     map[string]any{"type": "personal"},
+			Rules:    rules(),
+			Language: lang.New().GetDefault(),
+		})
+		require.Empty(t, errs)
+		assert.Nil(t, validationErrors)
+	})
+}
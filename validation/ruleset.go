@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"fmt"
 	"strings"
 
 	"slices"
@@ -58,6 +59,19 @@ type Validator interface {
 
 	overrideMessage(langEntry string)
 	getMessageOverride() string
+	setWarning()
+	isWarning() bool
+}
+
+// ValuesLister is an optional interface for validators whose parameters are a list
+// of values (e.g. `In`). If a validator implements it and its `MessagePlaceholders()`
+// doesn't already provide it, the ":values" placeholder is automatically added and
+// replaced by the listed values, separated by a comma.
+//
+// Wrapping validators (such as `OnlyIf()`) don't expose this interface. Validators that
+// may be wrapped should also return the placeholder from `MessagePlaceholders()`.
+type ValuesLister interface {
+	ListValues() []any
 }
 
 // BaseValidator composable structure that implements the basic functions required to
@@ -65,6 +79,7 @@ type Validator interface {
 type BaseValidator struct {
 	component
 	messageOverride string
+	warning         bool
 }
 
 func (v *BaseValidator) init(options *Options) {
@@ -98,6 +113,14 @@ func (v *BaseValidator) getMessageOverride() string {
 	return v.messageOverride
 }
 
+func (v *BaseValidator) setWarning() {
+	v.warning = true
+}
+
+func (v *BaseValidator) isWarning() bool {
+	return v.warning
+}
+
 // WithMessage set a custom language entry for the error message of a Validator.
 // Original placeholders returned by the validator are still used to render the message.
 // Type-dependent and "element" suffixes are not added when the message is overridden.
@@ -106,6 +129,25 @@ func WithMessage[V Validator](v V, langEntry string) V {
 	return v
 }
 
+// Warn marks a Validator as advisory: if it fails, its message (and the errors it added
+// using `Context.AddValidationError` and similar) are added to the validation warnings
+// instead of the validation errors and the field is still considered valid.
+// Warnings are only returned by `ValidateWithWarnings()`.
+//
+// Panics if the given validator is `Required()`, `RequiredIf()`, `RequiredIfMatches()` or a type
+// validator, as they change how the validation engine processes the field.
+func Warn[V Validator](v V) V {
+	switch any(v).(type) {
+	case *RequiredValidator, *RequiredIfValidator, *RequiredIfMatchesValidator:
+		panic(errors.NewSkip("validation.Warn: required validators cannot be marked as warnings", 3))
+	}
+	if v.IsType() {
+		panic(errors.NewSkip(fmt.Errorf("validation.Warn: type validator %q cannot be marked as warning", v.Name()), 3))
+	}
+	v.setWarning()
+	return v
+}
+
 // FieldRulesConverter types implementing this interface define their behavior
 // when converting a `FieldRules` to `Rules`. This enables rule sets composition.
 type FieldRulesConverter interface {
@@ -264,6 +306,50 @@ func includeElementsKeys(paths map[string]struct{}, path string, elementField *F
 	includeElementsKeys(paths, elementPath, elementField.Elements)
 }
 
+// Compile converts the given RuleSet to Rules, returning an error instead of panicking
+// if the RuleSet is invalid (malformed or duplicate paths for example).
+// This can be used to check rule sets ahead of time (at startup or in tests) instead of
+// discovering the problem when the first request is validated.
+//
+// The returned Rules are single-use: validators are initialized (and therefore modified)
+// when executed, so they must not be shared between multiple validations nor used concurrently.
+// Compile is only meant to check the validity of a rule set, it doesn't make validation faster.
+// Rule sets should still be created for each request.
+func Compile(ruleSet RuleSet) (rules Rules, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			rules = nil
+			err = errors.New(r)
+		}
+	}()
+	return ruleSet.AsRules(), nil
+}
+
+// RuleMap a convenient alternative to `RuleSet` associating each path with the
+// `List` of validators applied to the corresponding field.
+//
+// Because maps are not ordered, the fields are validated in the lexicographical order of
+// their paths, which guarantees parent fields are validated before their children.
+// Use a `RuleSet` if you need precise control over the validation order or if you
+// need composition.
+type RuleMap map[string]List
+
+// AsRules converts this RuleMap to a Rules structure.
+func (m RuleMap) AsRules() Rules {
+	return m.AsRuleSet().AsRules()
+}
+
+// AsRuleSet converts this RuleMap to an equivalent RuleSet.
+func (m RuleMap) AsRuleSet() RuleSet {
+	paths := lo.Keys(m)
+	slices.Sort(paths)
+	ruleSet := make(RuleSet, 0, len(m))
+	for _, path := range paths {
+		ruleSet = append(ruleSet, &FieldRules{Path: path, Rules: m[path]})
+	}
+	return ruleSet
+}
+
 // Rules is the result of the transformation of RuleSet using `AsRules()`.
 // It is a format that is more easily machine-readable than RuleSet.
 type Rules []*Field
@@ -3,7 +3,9 @@ package validation
 import (
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 	"goyave.dev/goyave/v5/config"
 	"goyave.dev/goyave/v5/lang"
@@ -367,6 +369,112 @@ func TestRuleSetRequired(t *testing.T) {
 	}
 }
 
+func TestCompile(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		ruleSet := RuleSet{
+			{Path: "object", Rules: List{Object()}},
+			{Path: "object.field", Rules: List{String()}},
+		}
+		rules, err := Compile(ruleSet)
+		require.NoError(t, err)
+		assert.Equal(t, ruleSet.AsRules(), rules)
+	})
+
+	t.Run("invalid_path", func(t *testing.T) {
+		rules, err := Compile(RuleSet{
+			{Path: "invalid[path.", Rules: List{String()}},
+		})
+		require.Error(t, err)
+		assert.Nil(t, rules)
+	})
+
+	t.Run("duplicate_path", func(t *testing.T) {
+		rules, err := Compile(RuleSet{
+			{Path: "field", Rules: List{String()}},
+			{Path: "field", Rules: List{Int()}},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `duplicate path "field" in rule set`)
+		assert.Nil(t, rules)
+	})
+}
+
+func benchmarkCompileRuleSet() RuleSet {
+	return RuleSet{
+		{Path: CurrentElement, Rules: List{Required(), Object()}},
+		{Path: "name", Rules: List{Required(), String(), Max(50)}},
+		{Path: "email", Rules: List{Required(), String(), Email()}},
+		{Path: "object", Rules: List{Required(), Object()}},
+		{Path: "object.property", Rules: List{Required(), Int()}},
+		{Path: "array", Rules: List{Required(), Array()}},
+		{Path: "array[]", Rules: List{Int()}},
+	}
+}
+
+func benchmarkCompileData() map[string]any {
+	return map[string]any{
+		"name":   "John",
+		"email":  "johndoe@example.org",
+		"object": map[string]any{"property": 1},
+		"array":  []any{1, 2, 3},
+	}
+}
+
+func BenchmarkValidateRuleSet(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		_, _ = Validate(&Options{
+			Data:     benchmarkCompileData(),
+			Rules:    benchmarkCompileRuleSet(),
+			Language: lang.Default,
+		})
+	}
+}
+
+func BenchmarkCompile(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := Compile(benchmarkCompileRuleSet()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestRuleMap(t *testing.T) {
+	ruleMap := RuleMap{
+		"object.field": {String()},
+		"object":       {Object()},
+		"array[]":      {Int()},
+		"array":        {Array()},
+	}
+
+	ruleSet := ruleMap.AsRuleSet()
+	assert.Equal(t, []string{"array", "array[]", "object", "object.field"}, lo.Map(ruleSet, func(f *FieldRules, _ int) string { return f.Path }))
+	assert.Equal(t, ruleSet.AsRules(), ruleMap.AsRules())
+
+	cases := []struct {
+		data map[string]any
+		want *Errors
+		desc string
+	}{
+		{desc: "pass", data: map[string]any{"contact": "johndoe@example.org"}, want: nil},
+		{desc: "missing", data: map[string]any{}, want: &Errors{Fields: FieldsErrors{"contact": {Errors: []string{"The contact is required.", "The contact must be a valid email address."}}}}},
+		{desc: "invalid", data: map[string]any{"contact": "johndoe"}, want: &Errors{Fields: FieldsErrors{"contact": {Errors: []string{"The contact must be a valid email address."}}}}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			validationErrors, errs := Validate(&Options{
+				Data:     c.data,
+				Rules:    RuleMap{"contact": {Required(), Email()}},
+				Language: lang.Default,
+			})
+			assert.Empty(t, errs)
+			assert.Equal(t, c.want, validationErrors)
+		})
+	}
+}
+
 func TestRules(t *testing.T) {
 	rules := Rules{{}, {}}
 	assert.Equal(t, rules, rules.AsRules())
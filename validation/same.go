@@ -13,6 +13,10 @@ import (
 // For arrays, objects and numbers, the values are compared using `reflect.DeepEqual()`.
 // For numbers, make sure the two compared numbers have the same type. A `uint` with value `1` will be considered
 // different from an `int` with value `1`.
+//
+// If the path contains arrays or wildcards, the field under validation must be equal to every
+// matched element. If an array (or an object matched by a wildcard) is empty, no element is
+// matched and the validator passes. Missing fields (including the arrays themselves) don't pass.
 type SameValidator struct {
 	Path *walk.Path
 	BaseValidator
@@ -29,8 +33,7 @@ func (v *SameValidator) Validate(ctx *Context) bool {
 	}
 
 	v.Path.Walk(ctx.Data, func(c *walk.Context) {
-		lastParent := c.Path.LastParent()
-		if lastParent != nil && lastParent.Type == walk.PathTypeArray && c.Found == walk.ElementNotFound {
+		if isEmptyCollection(c) {
 			return
 		}
 
@@ -74,10 +77,134 @@ func (v *SameValidator) MessagePlaceholders(_ *Context) []string {
 // For arrays, objects and numbers, the values are compared using `reflect.DeepEqual()`.
 // For numbers, make sure the two compared numbers have the same type. A `uint` with value `1` will be considered
 // different from an `int` with value `1`.
+//
+// If the path contains arrays or wildcards, the field under validation must be equal to every
+// matched element. If an array (or an object matched by a wildcard) is empty, no element is
+// matched and the validator passes. Missing fields (including the arrays themselves) don't pass.
 func Same(path string) *SameValidator {
+	v, err := SameE(path)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// SameE is the same as `Same()` but returns an error instead of panicking
+// if the given path cannot be parsed.
+func SameE(path string) (*SameValidator, error) {
+	p, err := walk.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("validation.Same: path parse error: %w", err)
+	}
+	return &SameValidator{Path: p}, nil
+}
+
+//------------------------------
+
+// SameArrayValidator validates the field under validation is an array equal to the array
+// identified by the given path. Elements are compared using `reflect.DeepEqual()`, so
+// they must have the same type.
+//
+// If `IgnoreOrder` is true, the arrays are considered equal if they contain the same elements,
+// the same number of times, in any order.
+//
+// If the path contains arrays or wildcards, every matched array must be equal to the field
+// under validation. If an array (or an object matched by a wildcard) is empty, no array is matched
+// and the validator passes. Missing fields don't pass.
+type SameArrayValidator struct {
+	Path *walk.Path
+	BaseValidator
+	IgnoreOrder bool
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *SameArrayValidator) Validate(ctx *Context) bool {
+	if GetFieldType(ctx.Value) != FieldTypeArray {
+		return false
+	}
+	ok := true
+
+	v.Path.Walk(ctx.Data, func(c *walk.Context) {
+		if isEmptyCollection(c) {
+			return
+		}
+
+		if c.Found != walk.Found || GetFieldType(c.Value) != FieldTypeArray {
+			ok = false
+			c.Break()
+			return
+		}
+
+		if v.IgnoreOrder {
+			ok = sameElements(reflect.ValueOf(ctx.Value), reflect.ValueOf(c.Value))
+		} else {
+			ok = reflect.DeepEqual(ctx.Value, c.Value)
+		}
+
+		if !ok {
+			c.Break()
+		}
+	})
+	return ok
+}
+
+// sameElements returns true if the two given slices contain the same elements,
+// the same number of times, regardless of their order.
+func sameElements(a, b reflect.Value) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	matched := make([]bool, b.Len())
+	for i := range a.Len() {
+		found := false
+		for j := range b.Len() {
+			if !matched[j] && reflect.DeepEqual(a.Index(i).Interface(), b.Index(j).Interface()) {
+				matched[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Name returns the string name of the validator.
+func (v *SameArrayValidator) Name() string { return "same_array" }
+
+// MessagePlaceholders returns the ":other" placeholder.
+func (v *SameArrayValidator) MessagePlaceholders(_ *Context) []string {
+	return []string{
+		":other", GetFieldName(v.Lang(), v.Path),
+	}
+}
+
+// SameArray validates the field under validation is an array equal to the array
+// identified by the given path. Elements are compared using `reflect.DeepEqual()`, so
+// they must have the same type. This is useful for confirmation fields.
+//
+// Set the `IgnoreOrder` field of the returned validator to true to accept arrays containing
+// the same elements, the same number of times, in any order.
+//
+// If the path contains arrays or wildcards, every matched array must be equal to the field
+// under validation. If an array (or an object matched by a wildcard) is empty, no array is matched
+// and the validator passes. Missing fields don't pass.
+func SameArray(path string) *SameArrayValidator {
+	v, err := SameArrayE(path)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// SameArrayE is the same as `SameArray()` but returns an error instead of panicking
+// if the given path cannot be parsed.
+func SameArrayE(path string) (*SameArrayValidator, error) {
 	p, err := walk.Parse(path)
 	if err != nil {
-		panic(errors.NewSkip(fmt.Errorf("validation.Same: path parse error: %w", err), 3))
+		return nil, fmt.Errorf("validation.SameArray: path parse error: %w", err)
 	}
-	return &SameValidator{Path: p}
+	return &SameArrayValidator{Path: p}, nil
 }
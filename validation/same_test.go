@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"goyave.dev/goyave/v5/lang"
 )
 
@@ -21,6 +22,13 @@ func TestSameValidator(t *testing.T) {
 		assert.Panics(t, func() {
 			Same("invalid[path.")
 		})
+
+		v2, err := SameE(path)
+		require.NoError(t, err)
+		assert.Equal(t, path, v2.Path.String())
+		v2, err = SameE("invalid[path.")
+		require.Error(t, err)
+		assert.Nil(t, v2)
 	})
 
 	cases := []struct {
@@ -70,3 +78,94 @@ func TestSameValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestSameValidatorWildcard(t *testing.T) {
+	cases := []struct {
+		data map[string]any
+		desc string
+		want bool
+	}{
+		{desc: "all_match", data: map[string]any{"items": []any{map[string]any{"currency": "EUR"}, map[string]any{"currency": "EUR"}}}, want: true},
+		{desc: "one_different", data: map[string]any{"items": []any{map[string]any{"currency": "EUR"}, map[string]any{"currency": "USD"}}}, want: false},
+		{desc: "zero_matches", data: map[string]any{"items": []any{}}, want: true},
+		{desc: "zero_matches_object", data: map[string]any{"items": map[string]any{}}, want: true},
+		{desc: "missing_currency", data: map[string]any{"items": []any{map[string]any{}}}, want: false},
+		{desc: "missing", data: map[string]any{}, want: false},
+		{desc: "not_a_collection", data: map[string]any{"items": 1}, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			v := Same("items.*.currency")
+			assert.Equal(t, c.want, v.Validate(&Context{
+				Value: "EUR",
+				Data:  c.data,
+			}))
+		})
+	}
+}
+
+func TestSameArrayValidator(t *testing.T) {
+	path := "object.field"
+	t.Run("Constructor", func(t *testing.T) {
+		v := SameArray(path)
+		v.lang = &lang.Language{}
+		assert.NotNil(t, v)
+		assert.Equal(t, "same_array", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.False(t, v.IgnoreOrder)
+		assert.Equal(t, []string{":other", "field"}, v.MessagePlaceholders(&Context{}))
+
+		assert.Panics(t, func() {
+			SameArray("invalid[path.")
+		})
+
+		v2, err := SameArrayE(path)
+		require.NoError(t, err)
+		assert.Equal(t, path, v2.Path.String())
+		v2, err = SameArrayE("invalid[path.")
+		require.Error(t, err)
+		assert.Nil(t, v2)
+	})
+
+	cases := []struct {
+		value       any
+		other       any
+		desc        string
+		ignoreOrder bool
+		want        bool
+	}{
+		{desc: "equal", value: []string{"a", "b", "c"}, other: []string{"a", "b", "c"}, want: true},
+		{desc: "equal_ignore_order", value: []string{"a", "b", "c"}, other: []string{"a", "b", "c"}, ignoreOrder: true, want: true},
+		{desc: "different_order", value: []string{"a", "b", "c"}, other: []string{"c", "a", "b"}, want: false},
+		{desc: "different_order_ignore_order", value: []string{"a", "b", "c"}, other: []string{"c", "a", "b"}, ignoreOrder: true, want: true},
+		{desc: "different_elements", value: []string{"a", "b", "c"}, other: []string{"a", "b", "d"}, ignoreOrder: true, want: false},
+		{desc: "different_length", value: []string{"a", "b"}, other: []string{"a", "b", "c"}, want: false},
+		{desc: "different_length_ignore_order", value: []string{"a", "b"}, other: []string{"b", "a", "a"}, ignoreOrder: true, want: false},
+		{desc: "different_count_ignore_order", value: []string{"a", "a", "b"}, other: []string{"a", "b", "b"}, ignoreOrder: true, want: false},
+		{desc: "objects_ignore_order", value: []any{map[string]any{"id": 1}, map[string]any{"id": 2}}, other: []any{map[string]any{"id": 2}, map[string]any{"id": 1}}, ignoreOrder: true, want: true},
+		{desc: "empty", value: []int{}, other: []int{}, want: true},
+		{desc: "different_types", value: []int{1, 2}, other: []float64{1, 2}, ignoreOrder: true, want: false},
+		{desc: "value_not_array", value: "a", other: []string{"a"}, want: false},
+		{desc: "other_not_array", value: []string{"a"}, other: "a", want: false},
+		{desc: "other_nil", value: []string{"a"}, other: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			v := SameArray(path)
+			v.IgnoreOrder = c.ignoreOrder
+			ctx := &Context{
+				Value: c.value,
+				Data:  map[string]any{"object": map[string]any{"field": c.other}},
+			}
+			assert.Equal(t, c.want, v.Validate(ctx))
+		})
+	}
+
+	t.Run("missing", func(t *testing.T) {
+		v := SameArray(path)
+		assert.False(t, v.Validate(&Context{Value: []string{"a"}, Data: map[string]any{}}))
+	})
+}
@@ -0,0 +1,47 @@
+package validation
+
+import "regexp"
+
+// SecretPatterns patterns of well-known secrets rejected by `NoSecretsValidator`:
+// AWS access key IDs, PEM private key headers and JSON Web Tokens.
+var SecretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`\b(?:AKIA|ASIA|AGPA|AIDA|AROA|ANPA|ANVA|AIPA)[0-9A-Z]{16}\b`),
+	regexp.MustCompile(`-----BEGIN (?:[A-Z0-9]+ )*PRIVATE KEY(?: BLOCK)?-----`),
+	regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`),
+}
+
+// NoSecretsValidator validates the field under validation must not contain a string
+// looking like a credential. This helps preventing users from accidentally submitting secrets.
+// The field doesn't pass if it matches one of the `SecretPatterns` or one of the additional `Patterns`.
+// Non-string values pass.
+type NoSecretsValidator struct {
+	BaseValidator
+	Patterns []*regexp.Regexp
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *NoSecretsValidator) Validate(ctx *Context) bool {
+	str, ok := ctx.Value.(string)
+	if !ok {
+		return true
+	}
+	for _, patterns := range [][]*regexp.Regexp{SecretPatterns, v.Patterns} {
+		for _, pattern := range patterns {
+			if pattern.MatchString(str) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Name returns the string name of the validator.
+func (v *NoSecretsValidator) Name() string { return "no_secrets" }
+
+// NoSecrets the field under validation must not contain a string looking like a credential.
+// This helps preventing users from accidentally submitting secrets.
+// The field doesn't pass if it matches one of the `SecretPatterns` or one of the given additional patterns.
+// Non-string values pass.
+func NoSecrets(extra ...*regexp.Regexp) *NoSecretsValidator {
+	return &NoSecretsValidator{Patterns: extra}
+}
@@ -0,0 +1,179 @@
+package validation
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"time"
+
+	"goyave.dev/goyave/v5/util/errors"
+)
+
+// Sort directions for the `Sorted()` validator.
+const (
+	SortAscending  = "asc"
+	SortDescending = "desc"
+)
+
+// SortedValidator validates the field under validation must be an array whose
+// elements are sorted in the given direction. Consecutive equal elements are accepted.
+// The elements must all be numbers, strings or `time.Time`. Numbers are compared
+// as `float64`, strings are compared lexicographically (byte-wise). Arrays mixing
+// types never pass.
+type SortedValidator struct {
+	BaseValidator
+	Direction string
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *SortedValidator) Validate(ctx *Context) bool {
+	index, ok := checkOrder(ctx.Value, func(c int) bool {
+		if v.Direction == SortDescending {
+			return c >= 0
+		}
+		return c <= 0
+	})
+	return ok && index == -1
+}
+
+// Name returns the string name of the validator.
+func (v *SortedValidator) Name() string { return "sorted" }
+
+// Sorted validates the field under validation must be an array whose
+// elements are sorted in the given direction (`SortAscending` or `SortDescending`).
+// Consecutive equal elements are accepted.
+// The elements must all be numbers, strings or `time.Time`. Numbers are compared
+// as `float64`, strings are compared lexicographically (byte-wise). Arrays mixing
+// types never pass.
+//
+// Panics if the direction is invalid.
+func Sorted(direction string) *SortedValidator {
+	if direction != SortAscending && direction != SortDescending {
+		panic(errors.NewSkip(fmt.Errorf("validation.Sorted: invalid direction %q", direction), 3))
+	}
+	return &SortedValidator{Direction: direction}
+}
+
+// checkOrder compares each element of the given array with the previous one and
+// returns the index of the first element for which the "ok" function returns false,
+// or -1 if all elements are in order.
+// The argument given to "ok" is the result of the comparison of the previous
+// element with the current one (-1 if lower, 0 if equal, +1 if greater).
+//
+// The second returned value is false if the value is not an array or if two
+// consecutive elements cannot be compared.
+func checkOrder(value any, ok func(c int) bool) (int, bool) {
+	list := reflect.ValueOf(value)
+	if list.Kind() != reflect.Slice {
+		return 0, false
+	}
+	for i := 1; i < list.Len(); i++ {
+		c, comparable := compareElements(list.Index(i-1).Interface(), list.Index(i).Interface())
+		if !comparable {
+			return i, false
+		}
+		if !ok(c) {
+			return i, true
+		}
+	}
+	return -1, true
+}
+
+// compareElements compares two numbers, two strings or two `time.Time`.
+// The second returned value is false if the values cannot be compared.
+func compareElements(a, b any) (int, bool) {
+	switch valA := a.(type) {
+	case string:
+		valB, ok := b.(string)
+		return cmp.Compare(valA, valB), ok
+	case time.Time:
+		valB, ok := b.(time.Time)
+		return valA.Compare(valB), ok
+	}
+
+	floatA, okA, errA := numberAsFloat64(a)
+	floatB, okB, errB := numberAsFloat64(b)
+	if !okA || !okB || errA != nil || errB != nil {
+		return 0, false
+	}
+	return cmp.Compare(floatA, floatB), true
+}
+
+//------------------------------
+
+// IncreasingValidator validates the field under validation must be an array whose elements
+// are greater than the previous one. If `Strict` is false, consecutive equal elements are accepted.
+// The elements must all be numbers, strings or `time.Time` (see `Sorted()`).
+//
+// The first element breaking the order is marked as invalid (see `Context.AddArrayElementValidationErrors`).
+// Arrays mixing types and values that are not arrays don't pass.
+type IncreasingValidator struct {
+	BaseValidator
+	Strict bool
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *IncreasingValidator) Validate(ctx *Context) bool {
+	return validateMonotonic(ctx, func(c int) bool {
+		return c < 0 || (!v.Strict && c == 0)
+	})
+}
+
+// Name returns the string name of the validator.
+func (v *IncreasingValidator) Name() string { return "increasing" }
+
+// Increasing the field under validation must be an array whose elements are greater than the
+// previous one. If "strict" is false, consecutive equal elements are accepted.
+// The elements must all be numbers, strings or `time.Time` (see `Sorted()`).
+//
+// The first element breaking the order is marked as invalid.
+// Arrays mixing types and values that are not arrays don't pass.
+func Increasing(strict bool) *IncreasingValidator {
+	return &IncreasingValidator{Strict: strict}
+}
+
+//------------------------------
+
+// DecreasingValidator validates the field under validation must be an array whose elements
+// are lower than the previous one. If `Strict` is false, consecutive equal elements are accepted.
+// The elements must all be numbers, strings or `time.Time` (see `Sorted()`).
+//
+// The first element breaking the order is marked as invalid (see `Context.AddArrayElementValidationErrors`).
+// Arrays mixing types and values that are not arrays don't pass.
+type DecreasingValidator struct {
+	BaseValidator
+	Strict bool
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *DecreasingValidator) Validate(ctx *Context) bool {
+	return validateMonotonic(ctx, func(c int) bool {
+		return c > 0 || (!v.Strict && c == 0)
+	})
+}
+
+// Name returns the string name of the validator.
+func (v *DecreasingValidator) Name() string { return "decreasing" }
+
+// Decreasing the field under validation must be an array whose elements are lower than the
+// previous one. If "strict" is false, consecutive equal elements are accepted.
+// The elements must all be numbers, strings or `time.Time` (see `Sorted()`).
+//
+// The first element breaking the order is marked as invalid.
+// Arrays mixing types and values that are not arrays don't pass.
+func Decreasing(strict bool) *DecreasingValidator {
+	return &DecreasingValidator{Strict: strict}
+}
+
+// validateMonotonic checks the order of the array under validation and marks
+// the first element breaking the order as invalid.
+func validateMonotonic(ctx *Context, ok func(c int) bool) bool {
+	index, valid := checkOrder(ctx.Value, ok)
+	if !valid {
+		return false
+	}
+	if index != -1 {
+		ctx.AddArrayElementValidationErrors(index)
+	}
+	return true
+}
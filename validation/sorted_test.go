@@ -0,0 +1,159 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goyave.dev/goyave/v5/lang"
+)
+
+func TestSortedValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := Sorted(SortAscending)
+		assert.NotNil(t, v)
+		assert.Equal(t, "sorted", v.Name())
+		assert.Equal(t, SortAscending, v.Direction)
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+
+		assert.Equal(t, SortDescending, Sorted(SortDescending).Direction)
+		assert.Panics(t, func() {
+			Sorted("invalid")
+		})
+	})
+
+	now := time.Now()
+	cases := []struct {
+		value     any
+		direction string
+		want      bool
+	}{
+		{value: []int{1, 2, 2, 3}, direction: SortAscending, want: true},
+		{value: []int{1, 3, 2}, direction: SortAscending, want: false},
+		{value: []int{3, 2, 2, 1}, direction: SortDescending, want: true},
+		{value: []int{1, 2, 3}, direction: SortDescending, want: false},
+		{value: []float64{-1.5, 0, 2.25}, direction: SortAscending, want: true},
+		{value: []any{1, 2.5, uint(3)}, direction: SortAscending, want: true},
+		{value: []string{"a", "ab", "b"}, direction: SortAscending, want: true},
+		{value: []string{"b", "a"}, direction: SortAscending, want: false},
+		{value: []string{"b", "a"}, direction: SortDescending, want: true},
+		{value: []time.Time{now, now.Add(time.Hour)}, direction: SortAscending, want: true},
+		{value: []time.Time{now, now.Add(time.Hour)}, direction: SortDescending, want: false},
+		{value: []int{42}, direction: SortAscending, want: true},
+		{value: []int{42}, direction: SortDescending, want: true},
+		{value: []any{}, direction: SortAscending, want: true},
+		{value: []any{1, "2"}, direction: SortAscending, want: false},
+		{value: []any{"a", now}, direction: SortAscending, want: false},
+		{value: []any{true, false}, direction: SortAscending, want: false},
+		{value: []any{nil, nil}, direction: SortAscending, want: false},
+		{value: "string", direction: SortAscending, want: false},
+		{value: 2, direction: SortAscending, want: false},
+		{value: map[string]any{"a": 1}, direction: SortAscending, want: false},
+		{value: nil, direction: SortAscending, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%s_%t", c.value, c.direction, c.want), func(t *testing.T) {
+			v := Sorted(c.direction)
+			assert.Equal(t, c.want, v.Validate(&Context{
+				Value: c.value,
+			}))
+		})
+	}
+}
+
+func TestIncreasingValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := Increasing(true)
+		assert.NotNil(t, v)
+		assert.Equal(t, "increasing", v.Name())
+		assert.True(t, v.Strict)
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+		assert.False(t, Increasing(false).Strict)
+	})
+
+	now := time.Now()
+	cases := []struct {
+		value        any
+		wantElements []int
+		strict       bool
+		want         bool
+	}{
+		{value: []int{1, 2, 3}, strict: true, want: true},
+		{value: []float64{0.5, 1, 1.5}, strict: false, want: true},
+		{value: []int{1, 1, 1}, strict: true, want: true, wantElements: []int{1}},
+		{value: []int{1, 1, 1}, strict: false, want: true},
+		{value: []int{3, 2, 1}, strict: false, want: true, wantElements: []int{1}},
+		{value: []int{1, 2, 5, 4, 6, 3}, strict: true, want: true, wantElements: []int{3}},
+		{value: []time.Time{now, now.Add(time.Second)}, strict: true, want: true},
+		{value: []time.Time{now, now.Add(-time.Second)}, strict: true, want: true, wantElements: []int{1}},
+		{value: []int{1}, strict: true, want: true},
+		{value: []any{}, strict: true, want: true},
+		{value: []any{1, "a"}, strict: true, want: false},
+		{value: "string", strict: true, want: false},
+		{value: nil, strict: true, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%t_%t", c.value, c.strict, c.want), func(t *testing.T) {
+			v := Increasing(c.strict)
+			ctx := &Context{Value: c.value}
+			assert.Equal(t, c.want, v.Validate(ctx))
+			assert.Equal(t, c.wantElements, ctx.ArrayElementErrors())
+		})
+	}
+
+	t.Run("Validate_message", func(t *testing.T) {
+		errs, err := Validate(&Options{
+			Data:     map[string]any{"values": []any{1, 2, 2, 3}},
+			Rules:    RuleSet{{Path: "values", Rules: List{Array(), Increasing(true)}}, {Path: "values[]", Rules: List{Int()}}},
+			Language: lang.New().GetDefault(),
+		})
+		require.Empty(t, err)
+		require.NotNil(t, errs)
+		assert.Equal(t, []string{"The values elements must be in increasing order."}, errs.Fields["values"].Elements[2].Errors)
+	})
+}
+
+func TestDecreasingValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := Decreasing(true)
+		assert.NotNil(t, v)
+		assert.Equal(t, "decreasing", v.Name())
+		assert.True(t, v.Strict)
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+		assert.False(t, Decreasing(false).Strict)
+	})
+
+	cases := []struct {
+		value        any
+		wantElements []int
+		strict       bool
+		want         bool
+	}{
+		{value: []int{3, 2, 1}, strict: true, want: true},
+		{value: []int{3, 3, 1}, strict: true, want: true, wantElements: []int{1}},
+		{value: []int{3, 3, 1}, strict: false, want: true},
+		{value: []int{1, 2, 3}, strict: false, want: true, wantElements: []int{1}},
+		{value: []string{"c", "b", "a"}, strict: true, want: true},
+		{value: []any{"a", 1}, strict: true, want: false},
+		{value: 1, strict: true, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%t_%t", c.value, c.strict, c.want), func(t *testing.T) {
+			v := Decreasing(c.strict)
+			ctx := &Context{Value: c.value}
+			assert.Equal(t, c.want, v.Validate(ctx))
+			assert.Equal(t, c.wantElements, ctx.ArrayElementErrors())
+		})
+	}
+}
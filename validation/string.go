@@ -1,9 +1,12 @@
 package validation
 
 import (
+	"bytes"
+	"io"
 	"strings"
 
 	"github.com/samber/lo"
+	"goyave.dev/goyave/v5/util/fsutil"
 )
 
 // StringValidator the field under validation must be a string.
@@ -61,6 +64,53 @@ func StartsWith(prefix ...string) *StartsWithValidator {
 
 //------------------------------
 
+// StartsWithBytesValidator the field under validation must be a file, a string or a byte slice
+// starting with the given bytes. For files, the leading bytes of the content are compared to the prefix,
+// which is useful to enforce a specific file signature (magic bytes). Multi-files are supported
+// (all files must satisfy the criteria).
+type StartsWithBytesValidator struct {
+	BaseValidator
+	Prefix []byte
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *StartsWithBytesValidator) Validate(ctx *Context) bool {
+	switch val := ctx.Value.(type) {
+	case string:
+		return strings.HasPrefix(val, string(v.Prefix))
+	case []byte:
+		return bytes.HasPrefix(val, v.Prefix)
+	case []fsutil.File:
+		return lo.EveryBy(val, func(file fsutil.File) bool {
+			return readFile(file, func(r io.Reader) bool {
+				buf := make([]byte, len(v.Prefix))
+				if _, err := io.ReadFull(r, buf); err != nil {
+					return false
+				}
+				return bytes.Equal(buf, v.Prefix)
+			})
+		})
+	}
+	return false
+}
+
+// Name returns the string name of the validator.
+func (v *StartsWithBytesValidator) Name() string { return "starts_with_bytes" }
+
+// StartsWithBytes the field under validation must be a file, a string or a byte slice
+// starting with the given bytes. For files, the leading bytes of the content are compared to the prefix,
+// which is useful to enforce a specific file signature (magic bytes). For example, to only
+// accept PNG images:
+//
+//	v.StartsWithBytes([]byte("\x89PNG\r\n\x1a\n"))
+//
+// Multi-files are supported (all files must satisfy the criteria).
+func StartsWithBytes(prefix []byte) *StartsWithBytesValidator {
+	return &StartsWithBytesValidator{Prefix: prefix}
+}
+
+//------------------------------
+
 // EndsWithValidator the field under validation must be a string ending
 // with at least one of the specified suffixes.
 type EndsWithValidator struct {
@@ -1,10 +1,16 @@
 package validation
 
 import (
+	"bytes"
 	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goyave.dev/goyave/v5/util/fsutil"
 )
 
 func TestStringValidator(t *testing.T) {
@@ -83,6 +89,55 @@ func TestStartsWithValidator(t *testing.T) {
 	}
 }
 
+func TestStartsWithBytesValidator(t *testing.T) {
+	pngSignature := []byte("\x89PNG\r\n\x1a\n")
+	t.Run("Constructor", func(t *testing.T) {
+		v := StartsWithBytes(pngSignature)
+		assert.NotNil(t, v)
+		assert.Equal(t, "starts_with_bytes", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+		assert.Equal(t, pngSignature, v.Prefix)
+	})
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, png.Encode(buf, image.NewRGBA(image.Rect(0, 0, 8, 8))))
+	pngImage := buf.Bytes()
+	buf = &bytes.Buffer{}
+	require.NoError(t, jpeg.Encode(buf, image.NewRGBA(image.Rect(0, 0, 8, 8)), nil))
+	jpegImage := buf.Bytes()
+
+	cases := []struct {
+		value any
+		desc  string
+		want  bool
+	}{
+		{desc: "png", value: createTestFiles(t, pngImage), want: true},
+		{desc: "multiple_png", value: createTestFiles(t, pngImage, pngImage), want: true},
+		{desc: "jpeg", value: createTestFiles(t, jpegImage), want: false},
+		{desc: "one_jpeg", value: createTestFiles(t, pngImage, jpegImage), want: false},
+		{desc: "too_short", value: createTestFiles(t, pngSignature[:4]), want: false},
+		{desc: "no_header", value: []fsutil.File{{MIMEType: "image/png"}}, want: false},
+		{desc: "string", value: string(pngSignature) + "content", want: true},
+		{desc: "string_no_prefix", value: "content", want: false},
+		{desc: "bytes", value: pngImage, want: true},
+		{desc: "bytes_no_prefix", value: jpegImage, want: false},
+		{desc: "bytes_too_short", value: pngSignature[:4], want: false},
+		{desc: "empty_bytes", value: []byte{}, want: false},
+		{desc: "nil", value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			v := StartsWithBytes(pngSignature)
+			assert.Equal(t, c.want, v.Validate(&Context{
+				Value: c.value,
+			}))
+		})
+	}
+}
+
 func TestEndsWithValidator(t *testing.T) {
 	t.Run("Constructor", func(t *testing.T) {
 		v := EndsWith("suf", "fix")
@@ -0,0 +1,138 @@
+package validation
+
+import (
+	"fmt"
+
+	"github.com/samber/lo"
+	"goyave.dev/goyave/v5/util/errors"
+	"goyave.dev/goyave/v5/util/walk"
+)
+
+// SubsetValidator validates the field under validation must be an array whose
+// elements all appear in at least one of the arrays matched by the specified path.
+type SubsetValidator[T comparable] struct {
+	Path *walk.Path
+	BaseValidator
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+// Always return false if the validated value is not of type `[]T`, or if there is no matched
+// array of type `[]T`.
+func (v *SubsetValidator[T]) Validate(ctx *Context) bool {
+	return validateSetRelation(ctx, v.Path, func(value, reference []T) bool {
+		return lo.Every(reference, value)
+	})
+}
+
+// Name returns the string name of the validator.
+func (v *SubsetValidator[T]) Name() string { return "subset" }
+
+// MessagePlaceholders returns the ":other" placeholder.
+func (v *SubsetValidator[T]) MessagePlaceholders(_ *Context) []string {
+	return []string{
+		":other", GetFieldName(v.Lang(), v.Path),
+	}
+}
+
+// Subset the field under validation must be an array whose elements all appear
+// in at least one of the arrays matched by the specified path.
+func Subset[T comparable](path string) *SubsetValidator[T] {
+	v, err := SubsetE[T](path)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// SubsetE is the same as `Subset()` but returns an error instead of panicking
+// if the given path cannot be parsed.
+func SubsetE[T comparable](path string) (*SubsetValidator[T], error) {
+	p, err := walk.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("validation.Subset: path parse error: %w", err)
+	}
+	return &SubsetValidator[T]{Path: p}, nil
+}
+
+//------------------------------
+
+// SupersetValidator validates the field under validation must be an array containing
+// all the elements of at least one of the arrays matched by the specified path.
+type SupersetValidator[T comparable] struct {
+	Path *walk.Path
+	BaseValidator
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+// Always return false if the validated value is not of type `[]T`, or if there is no matched
+// array of type `[]T`.
+func (v *SupersetValidator[T]) Validate(ctx *Context) bool {
+	return validateSetRelation(ctx, v.Path, func(value, reference []T) bool {
+		return lo.Every(value, reference)
+	})
+}
+
+// Name returns the string name of the validator.
+func (v *SupersetValidator[T]) Name() string { return "superset" }
+
+// MessagePlaceholders returns the ":other" placeholder.
+func (v *SupersetValidator[T]) MessagePlaceholders(_ *Context) []string {
+	return []string{
+		":other", GetFieldName(v.Lang(), v.Path),
+	}
+}
+
+// Superset the field under validation must be an array containing all the
+// elements of at least one of the arrays matched by the specified path.
+func Superset[T comparable](path string) *SupersetValidator[T] {
+	v, err := SupersetE[T](path)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// SupersetE is the same as `Superset()` but returns an error instead of panicking
+// if the given path cannot be parsed.
+func SupersetE[T comparable](path string) (*SupersetValidator[T], error) {
+	p, err := walk.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("validation.Superset: path parse error: %w", err)
+	}
+	return &SupersetValidator[T]{Path: p}, nil
+}
+
+// validateSetRelation returns true if the "relation" function returns true for the
+// field under validation and at least one of the arrays matched by the given path.
+// Empty `[]any` are treated as empty `[]T` because arrays stay `[]any` if they are empty,
+// even after the validation of their elements.
+func validateSetRelation[T comparable](ctx *Context, path *walk.Path, relation func(value, reference []T) bool) bool {
+	value, ok := asTypedSlice[T](ctx.Value)
+	if !ok {
+		return false
+	}
+
+	ok = false
+	path.Walk(ctx.Data, func(c *walk.Context) {
+		if c.Found != walk.Found {
+			return
+		}
+		reference, okList := asTypedSlice[T](c.Value)
+		if !okList {
+			return
+		}
+		if relation(value, reference) {
+			ok = true
+			c.Break()
+		}
+	})
+	return ok
+}
+
+func asTypedSlice[T comparable](value any) ([]T, bool) {
+	if empty, ok := value.([]any); ok && len(empty) == 0 {
+		return []T{}, true
+	}
+	list, ok := value.([]T)
+	return list, ok
+}
@@ -0,0 +1,119 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goyave.dev/goyave/v5/lang"
+)
+
+func TestSubsetValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := Subset[string]("allowed")
+		v.lang = &lang.Language{}
+		assert.NotNil(t, v)
+		assert.Equal(t, "subset", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Equal(t, []string{":other", "allowed"}, v.MessagePlaceholders(&Context{}))
+
+		assert.Panics(t, func() {
+			Subset[string](".path[")
+		})
+
+		v2, err := SubsetE[string]("allowed")
+		require.NoError(t, err)
+		assert.Equal(t, "allowed", v2.Path.String())
+		v2, err = SubsetE[string](".path[")
+		require.Error(t, err)
+		assert.Nil(t, v2)
+	})
+
+	cases := []struct {
+		value any
+		data  map[string]any
+		desc  string
+		want  bool
+	}{
+		{desc: "proper subset", value: []string{"a", "c"}, data: map[string]any{"allowed": []string{"a", "b", "c"}}, want: true},
+		{desc: "same set", value: []string{"a", "b"}, data: map[string]any{"allowed": []string{"b", "a"}}, want: true},
+		{desc: "element not in reference", value: []string{"a", "d"}, data: map[string]any{"allowed": []string{"a", "b", "c"}}, want: false},
+		{desc: "empty field array", value: []string{}, data: map[string]any{"allowed": []string{"a"}}, want: true},
+		{desc: "empty generic field array", value: []any{}, data: map[string]any{"allowed": []string{"a"}}, want: true},
+		{desc: "empty reference", value: []string{"a"}, data: map[string]any{"allowed": []any{}}, want: false},
+		{desc: "missing path", value: []string{"a"}, data: map[string]any{}, want: false},
+		{desc: "wrong reference type", value: []string{"a"}, data: map[string]any{"allowed": []int{1}}, want: false},
+		{desc: "wrong field type", value: []int{1}, data: map[string]any{"allowed": []string{"a"}}, want: false},
+		{desc: "not an array", value: "a", data: map[string]any{"allowed": []string{"a"}}, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%s_%t", c.desc, c.want), func(t *testing.T) {
+			v := Subset[string]("allowed")
+			assert.Equal(t, c.want, v.Validate(&Context{
+				Value: c.value,
+				Data:  c.data,
+			}))
+		})
+	}
+
+	t.Run("Validate_many_references", func(t *testing.T) {
+		v := Subset[string]("groups[].allowed")
+		data := map[string]any{"groups": []any{
+			map[string]any{"allowed": []string{"a"}},
+			map[string]any{"allowed": []string{"b", "c"}},
+		}}
+		assert.True(t, v.Validate(&Context{Value: []string{"c", "b"}, Data: data}))
+		assert.False(t, v.Validate(&Context{Value: []string{"a", "b"}, Data: data}))
+	})
+}
+
+func TestSupersetValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := Superset[string]("required")
+		v.lang = &lang.Language{}
+		assert.NotNil(t, v)
+		assert.Equal(t, "superset", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Equal(t, []string{":other", "required"}, v.MessagePlaceholders(&Context{}))
+
+		assert.Panics(t, func() {
+			Superset[string](".path[")
+		})
+
+		v2, err := SupersetE[string]("required")
+		require.NoError(t, err)
+		assert.Equal(t, "required", v2.Path.String())
+		v2, err = SupersetE[string](".path[")
+		require.Error(t, err)
+		assert.Nil(t, v2)
+	})
+
+	cases := []struct {
+		value any
+		data  map[string]any
+		desc  string
+		want  bool
+	}{
+		{desc: "proper superset", value: []string{"a", "b", "c"}, data: map[string]any{"required": []string{"a", "c"}}, want: true},
+		{desc: "same set", value: []string{"a", "b"}, data: map[string]any{"required": []string{"b", "a"}}, want: true},
+		{desc: "missing element", value: []string{"a"}, data: map[string]any{"required": []string{"a", "b"}}, want: false},
+		{desc: "empty field array", value: []string{}, data: map[string]any{"required": []string{"a"}}, want: false},
+		{desc: "empty reference", value: []string{"a"}, data: map[string]any{"required": []any{}}, want: true},
+		{desc: "missing path", value: []string{"a"}, data: map[string]any{}, want: false},
+		{desc: "not an array", value: "a", data: map[string]any{"required": []string{"a"}}, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%s_%t", c.desc, c.want), func(t *testing.T) {
+			v := Superset[string]("required")
+			assert.Equal(t, c.want, v.Validate(&Context{
+				Value: c.value,
+				Data:  c.data,
+			}))
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package validation
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"goyave.dev/goyave/v5/util/errors"
+	"goyave.dev/goyave/v5/util/walk"
+)
+
+// sumEpsilon the tolerance used when comparing sums to absorb
+// floating point rounding errors.
+const sumEpsilon = 1e-9
+
+// sumElements returns the sum of the elements of the given numeric array.
+// The second returned value is false if the value is not an array or if one of
+// its elements is not a number (or doesn't fit in `float64`).
+func sumElements(value any) (float64, bool) {
+	list := reflect.ValueOf(value)
+	if list.Kind() != reflect.Slice {
+		return 0, false
+	}
+	sum := 0.0
+	for i := 0; i < list.Len(); i++ {
+		n, ok, err := numberAsFloat64(list.Index(i).Interface())
+		if !ok || err != nil {
+			return 0, false
+		}
+		sum += n
+	}
+	return sum, true
+}
+
+// floatEquals returns true if the two numbers are equal within a small tolerance,
+// relative to their magnitude.
+func floatEquals(a, b float64) bool {
+	return math.Abs(a-b) <= sumEpsilon*math.Max(1, math.Max(math.Abs(a), math.Abs(b)))
+}
+
+// SumEqualsValidator validates the field under validation must be an array of numbers
+// whose sum equals the given target. The comparison tolerates floating point rounding errors.
+// Arrays containing non-numeric elements don't pass.
+type SumEqualsValidator struct {
+	BaseValidator
+	Target float64
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *SumEqualsValidator) Validate(ctx *Context) bool {
+	sum, ok := sumElements(ctx.Value)
+	return ok && floatEquals(sum, v.Target)
+}
+
+// Name returns the string name of the validator.
+func (v *SumEqualsValidator) Name() string { return "sum_equals" }
+
+// MessagePlaceholders returns the ":value" placeholder.
+func (v *SumEqualsValidator) MessagePlaceholders(_ *Context) []string {
+	return []string{
+		":value", fmt.Sprintf("%v", v.Target),
+	}
+}
+
+// SumEquals the field under validation must be an array of numbers whose sum equals
+// the given target. The comparison tolerates floating point rounding errors.
+// Arrays containing non-numeric elements don't pass.
+func SumEquals(target float64) *SumEqualsValidator {
+	return &SumEqualsValidator{Target: target}
+}
+
+//------------------------------
+
+// SumBetweenValidator validates the field under validation must be an array of numbers
+// whose sum is between the given min and max (inclusive). The comparison tolerates
+// floating point rounding errors. Arrays containing non-numeric elements don't pass.
+type SumBetweenValidator struct {
+	BaseValidator
+	Min float64
+	Max float64
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *SumBetweenValidator) Validate(ctx *Context) bool {
+	sum, ok := sumElements(ctx.Value)
+	if !ok {
+		return false
+	}
+	return (sum >= v.Min || floatEquals(sum, v.Min)) && (sum <= v.Max || floatEquals(sum, v.Max))
+}
+
+// Name returns the string name of the validator.
+func (v *SumBetweenValidator) Name() string { return "sum_between" }
+
+// MessagePlaceholders returns the ":min" and ":max" placeholder.
+func (v *SumBetweenValidator) MessagePlaceholders(_ *Context) []string {
+	return []string{
+		":min", fmt.Sprintf("%v", v.Min),
+		":max", fmt.Sprintf("%v", v.Max),
+	}
+}
+
+// SumBetween the field under validation must be an array of numbers whose sum is between
+// the given min and max (inclusive). The comparison tolerates floating point rounding errors.
+// Arrays containing non-numeric elements don't pass.
+func SumBetween(minSum, maxSum float64) *SumBetweenValidator {
+	return &SumBetweenValidator{Min: minSum, Max: maxSum}
+}
+
+//------------------------------
+
+// SumEqualsFieldValidator validates the field under validation must be an array of numbers
+// whose sum equals the number identified by the given path. The comparison tolerates floating
+// point rounding errors. If the path contains arrays or wildcards, the sum must be equal to every matched
+// element, and the validator passes if no element is matched because an array is empty.
+// Arrays containing non-numeric elements don't pass. If the target field is missing or is not a number,
+// the validator doesn't pass.
+type SumEqualsFieldValidator struct {
+	Path *walk.Path
+	BaseValidator
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *SumEqualsFieldValidator) Validate(ctx *Context) bool {
+	sum, ok := sumElements(ctx.Value)
+	if !ok {
+		return false
+	}
+
+	v.Path.Walk(ctx.Data, func(c *walk.Context) {
+		if isEmptyCollection(c) {
+			return
+		}
+
+		if c.Found != walk.Found {
+			ok = false
+			c.Break()
+			return
+		}
+
+		target, isNumber, err := numberAsFloat64(c.Value)
+		if !isNumber || err != nil || !floatEquals(sum, target) {
+			ok = false
+			c.Break()
+		}
+	})
+	return ok
+}
+
+// Name returns the string name of the validator.
+func (v *SumEqualsFieldValidator) Name() string { return "sum_equals_field" }
+
+// MessagePlaceholders returns the ":other" placeholder.
+func (v *SumEqualsFieldValidator) MessagePlaceholders(_ *Context) []string {
+	return []string{
+		":other", GetFieldName(v.Lang(), v.Path),
+	}
+}
+
+// SumEqualsField the field under validation must be an array of numbers whose sum equals
+// the number identified by the given path. The comparison tolerates floating point rounding errors.
+// If the path contains arrays or wildcards, the sum must be equal to every matched element,
+// and the validator passes if no element is matched because an array is empty.
+// Arrays containing non-numeric elements don't pass. If the target field is missing or is not a number,
+// the validator doesn't pass.
+func SumEqualsField(path string) *SumEqualsFieldValidator {
+	v, err := SumEqualsFieldE(path)
+	if err != nil {
+		panic(errors.NewSkip(err, 3))
+	}
+	return v
+}
+
+// SumEqualsFieldE is the same as `SumEqualsField()` but returns an error instead of panicking
+// if the given path cannot be parsed.
+func SumEqualsFieldE(path string) (*SumEqualsFieldValidator, error) {
+	p, err := walk.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("validation.SumEqualsField: path parse error: %w", err)
+	}
+	return &SumEqualsFieldValidator{Path: p}, nil
+}
@@ -0,0 +1,129 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goyave.dev/goyave/v5/lang"
+)
+
+func TestSumEqualsValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := SumEquals(100)
+		assert.NotNil(t, v)
+		assert.Equal(t, "sum_equals", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Equal(t, []string{":value", "100"}, v.MessagePlaceholders(&Context{}))
+	})
+
+	cases := []struct {
+		value  any
+		target float64
+		want   bool
+	}{
+		{value: []int{50, 25, 25}, target: 100, want: true},
+		{value: []int{50, 25, 24}, target: 100, want: false},
+		{value: []float64{33.3, 33.3, 33.4}, target: 100, want: true},
+		{value: []float64{0.1, 0.2}, target: 0.3, want: true},
+		{value: []any{50, 25.5, uint8(24)}, target: 99.5, want: true},
+		{value: []any{}, target: 0, want: true},
+		{value: []any{}, target: 100, want: false},
+		{value: []any{50, "50"}, target: 100, want: false},
+		{value: []any{50, nil}, target: 50, want: false},
+		{value: 100, target: 100, want: false},
+		{value: "100", target: 100, want: false},
+		{value: nil, target: 0, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%v_%t", c.value, c.target, c.want), func(t *testing.T) {
+			v := SumEquals(c.target)
+			assert.Equal(t, c.want, v.Validate(&Context{Value: c.value}))
+		})
+	}
+}
+
+func TestSumBetweenValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := SumBetween(10, 20.5)
+		assert.NotNil(t, v)
+		assert.Equal(t, "sum_between", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Equal(t, []string{":min", "10", ":max", "20.5"}, v.MessagePlaceholders(&Context{}))
+	})
+
+	cases := []struct {
+		value any
+		min   float64
+		max   float64
+		want  bool
+	}{
+		{value: []int{5, 10}, min: 10, max: 20, want: true},
+		{value: []int{5, 5}, min: 10, max: 20, want: true},
+		{value: []int{10, 10}, min: 10, max: 20, want: true},
+		{value: []int{5, 4}, min: 10, max: 20, want: false},
+		{value: []int{20, 1}, min: 10, max: 20, want: false},
+		{value: []float64{0.1, 0.2}, min: 0, max: 0.3, want: true},
+		{value: []any{5, "10"}, min: 10, max: 20, want: false},
+		{value: 15, min: 10, max: 20, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%v_%v_%v_%t", c.value, c.min, c.max, c.want), func(t *testing.T) {
+			v := SumBetween(c.min, c.max)
+			assert.Equal(t, c.want, v.Validate(&Context{Value: c.value}))
+		})
+	}
+}
+
+func TestSumEqualsFieldValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := SumEqualsField("total")
+		v.lang = &lang.Language{}
+		assert.NotNil(t, v)
+		assert.Equal(t, "sum_equals_field", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Equal(t, []string{":other", "total"}, v.MessagePlaceholders(&Context{}))
+
+		assert.Panics(t, func() {
+			SumEqualsField("invalid[path.")
+		})
+
+		v2, err := SumEqualsFieldE("total")
+		require.NoError(t, err)
+		assert.Equal(t, "total", v2.Path.String())
+		v2, err = SumEqualsFieldE("invalid[path.")
+		require.Error(t, err)
+		assert.Nil(t, v2)
+	})
+
+	cases := []struct {
+		value any
+		data  map[string]any
+		desc  string
+		want  bool
+	}{
+		{desc: "equal", value: []float64{10.10, 20.20, 0.03}, data: map[string]any{"total": 30.33}, want: true},
+		{desc: "equal ints", value: []int{10, 20}, data: map[string]any{"total": 30}, want: true},
+		{desc: "off by a cent", value: []float64{10.10, 20.20}, data: map[string]any{"total": 30.31}, want: false},
+		{desc: "missing target", value: []float64{10.10, 20.20}, data: map[string]any{}, want: false},
+		{desc: "target not a number", value: []int{10, 20}, data: map[string]any{"total": "30"}, want: false},
+		{desc: "non-numeric element", value: []any{10, "20"}, data: map[string]any{"total": 30}, want: false},
+		{desc: "not an array", value: 30, data: map[string]any{"total": 30}, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%s_%t", c.desc, c.want), func(t *testing.T) {
+			v := SumEqualsField("total")
+			assert.Equal(t, c.want, v.Validate(&Context{
+				Value: c.value,
+				Data:  c.data,
+			}))
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package validation
+
+import (
+	"strings"
+	"text/template"
+)
+
+// GoTemplateValidator validates the field under validation must be a string
+// with a valid `text/template` syntax. The template is only parsed, not executed.
+// Functions used in the template must be declared in `Funcs`, otherwise the
+// template is considered invalid.
+type GoTemplateValidator struct {
+	BaseValidator
+	Funcs template.FuncMap
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *GoTemplateValidator) Validate(ctx *Context) bool {
+	str, ok := ctx.Value.(string)
+	if !ok {
+		return false
+	}
+	return v.parse(str) == nil
+}
+
+func (v *GoTemplateValidator) parse(str string) error {
+	_, err := template.New("").Funcs(v.Funcs).Parse(str)
+	return err
+}
+
+// Name returns the string name of the validator.
+func (v *GoTemplateValidator) Name() string { return "go_template" }
+
+// MessagePlaceholders returns the ":error" placeholder, containing the location and the
+// description of the parse error (e.g.: "line 1: unclosed action").
+func (v *GoTemplateValidator) MessagePlaceholders(ctx *Context) []string {
+	message := ""
+	if str, ok := ctx.Value.(string); ok {
+		if err := v.parse(str); err != nil {
+			message = "line " + strings.TrimPrefix(err.Error(), "template: :")
+		}
+	}
+	return []string{
+		":error", message,
+	}
+}
+
+// GoTemplate the field under validation must be a string with a valid `text/template` syntax.
+// The template is only parsed, not executed. The given functions are made available
+// to the template: using an undeclared function makes the template invalid.
+func GoTemplate(funcs ...template.FuncMap) *GoTemplateValidator {
+	v := &GoTemplateValidator{Funcs: template.FuncMap{}}
+	for _, f := range funcs {
+		for name, fn := range f {
+			v.Funcs[name] = fn
+		}
+	}
+	return v
+}
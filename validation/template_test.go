@@ -0,0 +1,66 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goyave.dev/goyave/v5/lang"
+)
+
+func TestGoTemplateValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := GoTemplate()
+		assert.NotNil(t, v)
+		assert.Equal(t, "go_template", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.Funcs)
+		assert.Equal(t, []string{":error", ""}, v.MessagePlaceholders(&Context{Value: "{{ .Name }}"}))
+		assert.Equal(t, []string{":error", "line 2: unclosed action"}, v.MessagePlaceholders(&Context{Value: "Hello\n{{ .Name "}))
+
+		v = GoTemplate(template.FuncMap{"upper": strings.ToUpper}, template.FuncMap{"lower": strings.ToLower})
+		assert.Len(t, v.Funcs, 2)
+	})
+
+	cases := []struct {
+		value any
+		desc  string
+		want  bool
+	}{
+		{desc: "valid", value: "Hello {{ .Name }}{{ if .Admin }} (admin){{ end }}", want: true},
+		{desc: "no action", value: "Hello", want: true},
+		{desc: "empty", value: "", want: true},
+		{desc: "unclosed action", value: "Hello {{ .Name ", want: false},
+		{desc: "unclosed if", value: "{{ if .Admin }}admin", want: false},
+		{desc: "undefined function", value: "{{ upper .Name }}", want: false},
+		{desc: "not a string", value: 1, want: false},
+		{desc: "nil", value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%s_%t", c.desc, c.want), func(t *testing.T) {
+			v := GoTemplate()
+			assert.Equal(t, c.want, v.Validate(&Context{Value: c.value}))
+		})
+	}
+
+	t.Run("Validate_funcs", func(t *testing.T) {
+		v := GoTemplate(template.FuncMap{"upper": strings.ToUpper})
+		assert.True(t, v.Validate(&Context{Value: "{{ upper .Name }}"}))
+	})
+
+	t.Run("Validate_message", func(t *testing.T) {
+		errs, err := Validate(&Options{
+			Data:     map[string]any{"template": "Hello {{ .Name "},
+			Rules:    RuleSet{{Path: "template", Rules: List{String(), GoTemplate()}}},
+			Language: lang.New().GetDefault(),
+		})
+		require.Empty(t, err)
+		require.NotNil(t, errs)
+		assert.Equal(t, []string{"The template must be a valid Go template (line 1: unclosed action)."}, errs.Fields["template"].Errors)
+	})
+}
@@ -0,0 +1,192 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+	"golang.org/x/text/unicode/norm"
+	"goyave.dev/goyave/v5/util/errors"
+)
+
+// ValidUTF8Validator validates the field under validation must be a string only
+// containing valid UTF-8 sequences.
+//
+// If `Coerce` is true, the validator passes for any string and replaces each run
+// of invalid bytes with the Unicode replacement character (U+FFFD) instead.
+type ValidUTF8Validator struct {
+	BaseValidator
+	Coerce bool
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *ValidUTF8Validator) Validate(ctx *Context) bool {
+	str, ok := ctx.Value.(string)
+	if !ok {
+		return false
+	}
+	if utf8.ValidString(str) {
+		return true
+	}
+	if v.Coerce {
+		ctx.Value = strings.ToValidUTF8(str, string(utf8.RuneError))
+		return true
+	}
+	return false
+}
+
+// Name returns the string name of the validator.
+func (v *ValidUTF8Validator) Name() string { return "utf8" }
+
+// ValidUTF8 the field under validation must be a string only containing valid UTF-8 sequences.
+//
+// Set the `Coerce` field of the returned validator to true to replace the invalid bytes with
+// the Unicode replacement character (U+FFFD) instead of failing.
+func ValidUTF8() *ValidUTF8Validator {
+	return &ValidUTF8Validator{}
+}
+
+//------------------------------
+
+var normalizationForms = map[string]norm.Form{
+	"NFC":  norm.NFC,
+	"NFD":  norm.NFD,
+	"NFKC": norm.NFKC,
+	"NFKD": norm.NFKD,
+}
+
+// NormalizedValidator validates the field under validation must be a string already
+// in the given Unicode normalization form ("NFC", "NFD", "NFKC" or "NFKD").
+//
+// If `Normalize` is true, the validator passes for any string and replaces
+// the value with its normalized form instead.
+type NormalizedValidator struct {
+	BaseValidator
+	Form      string
+	Normalize bool
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *NormalizedValidator) Validate(ctx *Context) bool {
+	str, ok := ctx.Value.(string)
+	if !ok {
+		return false
+	}
+	form := normalizationForms[v.Form]
+	if form.IsNormalString(str) {
+		return true
+	}
+	if v.Normalize {
+		ctx.Value = form.String(str)
+		return true
+	}
+	return false
+}
+
+// Name returns the string name of the validator.
+func (v *NormalizedValidator) Name() string { return "normalized" }
+
+// MessagePlaceholders returns the ":form" placeholder.
+func (v *NormalizedValidator) MessagePlaceholders(_ *Context) []string {
+	return []string{
+		":form", v.Form,
+	}
+}
+
+// Normalized the field under validation must be a string already in the given Unicode
+// normalization form ("NFC", "NFD", "NFKC" or "NFKD"). This is useful to make sure
+// that visually identical strings (such as usernames) are compared consistently.
+//
+// Set the `Normalize` field of the returned validator to true to normalize the value
+// instead of failing.
+//
+// Panics if the form is invalid.
+func Normalized(form string) *NormalizedValidator {
+	if _, ok := normalizationForms[form]; !ok {
+		panic(errors.NewSkip(fmt.Errorf("validation.Normalized: invalid normalization form %q", form), 3))
+	}
+	return &NormalizedValidator{Form: form}
+}
+
+//------------------------------
+
+// NoConfusablesValidator validates the field under validation must be a string whose letters
+// all belong to the same Unicode script (as defined by `unicode.Scripts`), unless all the scripts used
+// are in `AllowedScripts`. Characters shared by all scripts, such as digits, punctuation or combining
+// marks, are ignored. Non-string values never pass.
+type NoConfusablesValidator struct {
+	BaseValidator
+	AllowedScripts []string
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *NoConfusablesValidator) Validate(ctx *Context) bool {
+	str, ok := ctx.Value.(string)
+	if !ok {
+		return false
+	}
+
+	scripts := make([]string, 0, 2)
+	for _, r := range str {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		script := runeScript(r)
+		if script == "" || lo.Contains(scripts, script) {
+			continue
+		}
+		scripts = append(scripts, script)
+	}
+
+	if len(scripts) <= 1 {
+		return true
+	}
+	for _, script := range scripts {
+		if !lo.Contains(v.AllowedScripts, script) {
+			return false
+		}
+	}
+	return true
+}
+
+// runeScript returns the name of the Unicode script the given rune belongs to.
+// Returns an empty string if the rune is shared by several scripts ("Common" or "Inherited")
+// or doesn't belong to any.
+func runeScript(r rune) string {
+	if r < utf8.RuneSelf {
+		if unicode.Is(unicode.Latin, r) {
+			return "Latin"
+		}
+		return ""
+	}
+	for name, table := range unicode.Scripts {
+		if name == "Common" || name == "Inherited" {
+			continue
+		}
+		if unicode.Is(table, r) {
+			return name
+		}
+	}
+	return ""
+}
+
+// Name returns the string name of the validator.
+func (v *NoConfusablesValidator) Name() string { return "no_confusables" }
+
+// NoConfusables the field under validation must be a string whose letters all belong to the same
+// Unicode script, which prevents homograph spoofing (e.g. a Cyrillic "а" among Latin letters in a username).
+// Strings mixing several scripts are accepted only if all of them are in the given list of allowed scripts.
+// Script names are the keys of `unicode.Scripts` (e.g. "Latin", "Cyrillic", "Han", "Hiragana").
+// Characters shared by all scripts, such as digits, punctuation or combining marks, are ignored.
+//
+// Panics if one of the allowed scripts is unknown.
+func NoConfusables(allowedScripts ...string) *NoConfusablesValidator {
+	for _, script := range allowedScripts {
+		if _, ok := unicode.Scripts[script]; !ok {
+			panic(errors.NewSkip(fmt.Errorf("validation.NoConfusables: unknown script %q", script), 3))
+		}
+	}
+	return &NoConfusablesValidator{AllowedScripts: allowedScripts}
+}
@@ -0,0 +1,175 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidUTF8Validator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := ValidUTF8()
+		assert.NotNil(t, v)
+		assert.Equal(t, "utf8", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+		assert.False(t, v.Coerce)
+	})
+
+	cases := []struct {
+		value any
+		desc  string
+		want  bool
+	}{
+		{desc: "ascii", value: "hello", want: true},
+		{desc: "multi-byte", value: "héllo 世界 🎉", want: true},
+		{desc: "empty", value: "", want: true},
+		{desc: "invalid byte", value: "hel\xfflo", want: false},
+		{desc: "truncated sequence", value: "\xe4\xb8", want: false},
+		{desc: "surrogate", value: "\xed\xa0\x80", want: false},
+		{desc: "not a string", value: []byte("hello"), want: false},
+		{desc: "number", value: 1, want: false},
+		{desc: "nil", value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%s_%t", c.desc, c.want), func(t *testing.T) {
+			v := ValidUTF8()
+			ctx := &Context{Value: c.value}
+			assert.Equal(t, c.want, v.Validate(ctx))
+			assert.Equal(t, c.value, ctx.Value)
+		})
+	}
+
+	t.Run("Coerce", func(t *testing.T) {
+		v := ValidUTF8()
+		v.Coerce = true
+
+		ctx := &Context{Value: "hel\xff\xfelo"}
+		assert.True(t, v.Validate(ctx))
+		assert.Equal(t, "hel�lo", ctx.Value)
+
+		ctx = &Context{Value: "héllo"}
+		assert.True(t, v.Validate(ctx))
+		assert.Equal(t, "héllo", ctx.Value)
+
+		ctx = &Context{Value: 1}
+		assert.False(t, v.Validate(ctx))
+	})
+}
+
+func TestNormalizedValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := Normalized("NFC")
+		assert.NotNil(t, v)
+		assert.Equal(t, "normalized", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Equal(t, []string{":form", "NFC"}, v.MessagePlaceholders(&Context{}))
+		assert.Equal(t, "NFC", v.Form)
+		assert.False(t, v.Normalize)
+
+		assert.Panics(t, func() {
+			Normalized("nfc")
+		})
+	})
+
+	composed := "caf\u00e9"    // "café" with a precomposed "é"
+	decomposed := "cafe\u0301" // "café" with "e" followed by a combining acute accent
+
+	cases := []struct {
+		value any
+		form  string
+		want  bool
+	}{
+		{value: "hello", form: "NFC", want: true},
+		{value: "", form: "NFC", want: true},
+		{value: composed, form: "NFC", want: true},
+		{value: decomposed, form: "NFC", want: false},
+		{value: composed, form: "NFD", want: false},
+		{value: decomposed, form: "NFD", want: true},
+		{value: "\ufb01", form: "NFC", want: true}, // "fi" ligature
+		{value: "\ufb01", form: "NFKC", want: false},
+		{value: "fi", form: "NFKC", want: true},
+		{value: "\ufb01", form: "NFKD", want: false},
+		{value: 1, form: "NFC", want: false},
+		{value: []byte("hello"), form: "NFC", want: false},
+		{value: nil, form: "NFC", want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%s_%q_%t", c.form, c.value, c.want), func(t *testing.T) {
+			v := Normalized(c.form)
+			ctx := &Context{Value: c.value}
+			assert.Equal(t, c.want, v.Validate(ctx))
+			assert.Equal(t, c.value, ctx.Value)
+		})
+	}
+
+	t.Run("Normalize", func(t *testing.T) {
+		v := Normalized("NFC")
+		v.Normalize = true
+
+		ctx := &Context{Value: decomposed}
+		assert.True(t, v.Validate(ctx))
+		assert.Equal(t, composed, ctx.Value)
+
+		v = Normalized("NFKC")
+		v.Normalize = true
+		ctx = &Context{Value: "\ufb01le"}
+		assert.True(t, v.Validate(ctx))
+		assert.Equal(t, "file", ctx.Value)
+
+		ctx = &Context{Value: 1}
+		assert.False(t, v.Validate(ctx))
+	})
+}
+
+func TestNoConfusablesValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := NoConfusables()
+		assert.NotNil(t, v)
+		assert.Equal(t, "no_confusables", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+		assert.Empty(t, v.AllowedScripts)
+
+		v = NoConfusables("Han", "Hiragana")
+		assert.Equal(t, []string{"Han", "Hiragana"}, v.AllowedScripts)
+
+		assert.Panics(t, func() {
+			NoConfusables("Klingon")
+		})
+	})
+
+	cases := []struct {
+		value   any
+		desc    string
+		allowed []string
+		want    bool
+	}{
+		{desc: "latin", value: "paypal", want: true},
+		{desc: "latin_accents", value: "Zoë_Müller-42", want: true},
+		{desc: "latin_combining_mark", value: "cafe\u0301", want: true},
+		{desc: "cyrillic", value: "привет", want: true},
+		{desc: "empty", value: "", want: true},
+		{desc: "no_letters", value: "1234-_!", want: true},
+		{desc: "mixed_latin_cyrillic", value: "p\u0430ypal", want: false}, // Cyrillic "а"
+		{desc: "mixed_latin_greek", value: "\u03bfk", want: false},        // Greek "ο"
+		{desc: "mixed_allowed", value: "漢字ひらがな", allowed: []string{"Han", "Hiragana"}, want: true},
+		{desc: "mixed_partially_allowed", value: "漢字ひらがなabc", allowed: []string{"Han", "Hiragana"}, want: false},
+		{desc: "mixed_latin_cyrillic_allowed", value: "p\u0430ypal", allowed: []string{"Latin", "Cyrillic"}, want: true},
+		{desc: "number", value: 1, want: false},
+		{desc: "nil", value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%s_%t", c.desc, c.want), func(t *testing.T) {
+			v := NoConfusables(c.allowed...)
+			assert.Equal(t, c.want, v.Validate(&Context{Value: c.value}))
+		})
+	}
+}
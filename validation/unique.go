@@ -317,3 +317,184 @@ func UniqueArray[T any](table, column string, transform func(val T) clause.Expr)
 		},
 	}
 }
+
+//------------------------------
+
+// CheckFunc function looking up the given value in a data store (a database for example).
+// Returns true if the value was found. The returned error is an internal failure
+// (e.g. a lost connection), not a validation failure.
+type CheckFunc func(ctx context.Context, value any) (bool, error)
+
+// UniqueFuncValidator validates the field under validation must have a unique value
+// according to the `Check` function, which returns true if the value is already taken.
+// Unlike `UniqueValidator`, this validator doesn't depend on Gorm: the lookup is delegated to
+// the given function, which can use any data store.
+//
+// If the function returns an error, it is added to the validation context (see `Context.AddError()`)
+// and returned by `Validate()` as an internal error instead of a validation error message.
+// The function is not called if a previous validator failed on the field.
+type UniqueFuncValidator struct {
+	Check CheckFunc
+	BaseValidator
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *UniqueFuncValidator) Validate(ctx *Context) bool {
+	if ctx.Invalid {
+		return true
+	}
+	found, err := v.Check(ctx.Context, ctx.Value)
+	if err != nil {
+		ctx.AddError(errors.New(err))
+		return false
+	}
+	return !found
+}
+
+// Name returns the string name of the validator.
+func (v *UniqueFuncValidator) Name() string { return "unique" }
+
+// UniqueFunc validates the field under validation must have a unique value
+// according to the given function, which returns true if the value is already taken.
+// This keeps the validation independent from the data store.
+//
+//	v.UniqueFunc(func(ctx context.Context, val any) (bool, error) {
+//		return userRepository.EmailExists(ctx, val.(string))
+//	})
+//
+// If the function returns an error, it is returned by `Validate()` as an internal error
+// instead of a validation error message.
+// The function is not called if a previous validator failed on the field.
+func UniqueFunc(check CheckFunc) *UniqueFuncValidator {
+	return &UniqueFuncValidator{Check: check}
+}
+
+//------------------------------
+
+// ExistsFuncValidator validates the field under validation must exist according to
+// the `Check` function, which returns true if the value was found.
+// Unlike `ExistsValidator`, this validator doesn't depend on Gorm: the lookup is delegated to
+// the given function, which can use any data store.
+//
+// If the function returns an error, it is added to the validation context (see `Context.AddError()`)
+// and returned by `Validate()` as an internal error instead of a validation error message.
+// The function is not called if a previous validator failed on the field.
+type ExistsFuncValidator struct {
+	Check CheckFunc
+	BaseValidator
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *ExistsFuncValidator) Validate(ctx *Context) bool {
+	if ctx.Invalid {
+		return true
+	}
+	found, err := v.Check(ctx.Context, ctx.Value)
+	if err != nil {
+		ctx.AddError(errors.New(err))
+		return false
+	}
+	return found
+}
+
+// Name returns the string name of the validator.
+func (v *ExistsFuncValidator) Name() string { return "exists" }
+
+// ExistsFunc validates the field under validation must exist according to the
+// given function, which returns true if the value was found.
+// This keeps the validation independent from the data store.
+//
+//	v.ExistsFunc(func(ctx context.Context, val any) (bool, error) {
+//		return productRepository.Exists(ctx, val.(int))
+//	})
+//
+// If the function returns an error, it is returned by `Validate()` as an internal error
+// instead of a validation error message.
+// The function is not called if a previous validator failed on the field.
+func ExistsFunc(check CheckFunc) *ExistsFuncValidator {
+	return &ExistsFuncValidator{Check: check}
+}
+
+//------------------------------
+
+// BatchCheckFunc function looking up all the given values at once in a data store (a database
+// for example). The returned map associates each found value with `true`. Values absent from the
+// map are considered not found. The returned error is an internal failure (e.g. a lost connection),
+// not a validation failure.
+type BatchCheckFunc func(ctx context.Context, values []any) (map[any]bool, error)
+
+// BatchExistsValidator validates each element of the array under validation must exist
+// according to the `Check` function. Unlike `ExistsFuncValidator` used on each element,
+// all the elements are checked in a single call, which avoids executing one query per element.
+// The elements that were not found are marked as invalid individually.
+//
+// The function receives the distinct values of the array. Elements that cannot be used as
+// map keys (such as objects) are marked invalid without being checked.
+// If the function returns an error, it is added to the validation context (see `Context.AddError()`)
+// and returned by `Validate()` as an internal error instead of a validation error message.
+// The function is not called if a previous validator failed on the field.
+type BatchExistsValidator struct {
+	Check BatchCheckFunc
+	BaseValidator
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *BatchExistsValidator) Validate(ctx *Context) bool {
+	if ctx.Invalid || GetFieldType(ctx.Value) != FieldTypeArray {
+		return true
+	}
+	values, _ := makeGenericSlice(ctx.Value)
+
+	distinct := make([]any, 0, len(values))
+	seen := make(map[any]struct{}, len(values))
+	for _, val := range values {
+		if !isComparable(val) {
+			continue
+		}
+		if _, ok := seen[val]; !ok {
+			seen[val] = struct{}{}
+			distinct = append(distinct, val)
+		}
+	}
+
+	found := map[any]bool{}
+	if len(distinct) > 0 {
+		var err error
+		found, err = v.Check(ctx.Context, distinct)
+		if err != nil {
+			ctx.AddError(errors.New(err))
+			return false
+		}
+	}
+
+	for i, val := range values {
+		if !isComparable(val) || !found[val] {
+			ctx.AddArrayElementValidationErrors(i)
+		}
+	}
+	return true
+}
+
+func isComparable(val any) bool {
+	return val == nil || reflect.TypeOf(val).Comparable()
+}
+
+// Name returns the string name of the validator.
+func (v *BatchExistsValidator) Name() string { return "exists" }
+
+// BatchExists validates each element of the array under validation must exist according to
+// the given function, which checks all the distinct elements in a single call and returns the found ones.
+// The elements that were not found are marked as invalid individually.
+// This validator must be used on the array itself, not on its elements:
+//
+//	{Path: "ids", Rules: v.List{v.Required(), v.Array(), v.BatchExists(func(ctx context.Context, values []any) (map[any]bool, error) {
+//		return productRepository.ExistingIDs(ctx, values)
+//	})}},
+//	{Path: "ids[]", Rules: v.List{v.Int()}},
+//
+// If the function returns an error, it is returned by `Validate()` as an internal error
+// instead of a validation error message.
+// The function is not called if a previous validator failed on the field.
+func BatchExists(check BatchCheckFunc) *BatchExistsValidator {
+	return &BatchExistsValidator{Check: check}
+}
@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -17,6 +18,7 @@ import (
 	"gorm.io/gorm/clause"
 	"goyave.dev/goyave/v5/config"
 	"goyave.dev/goyave/v5/database"
+	"goyave.dev/goyave/v5/lang"
 )
 
 type uniqueTestModel struct {
@@ -259,6 +261,205 @@ func TestExistsValidator(t *testing.T) {
 	}
 }
 
+func stubChecker(existing ...any) CheckFunc {
+	return func(ctx context.Context, value any) (bool, error) {
+		if ctx != nil && ctx.Value(testCheckerKey{}) != nil {
+			return false, fmt.Errorf("connection lost")
+		}
+		return lo.Contains(existing, value), nil
+	}
+}
+
+type testCheckerKey struct{}
+
+func TestUniqueFuncValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := UniqueFunc(stubChecker())
+		assert.NotNil(t, v)
+		assert.Equal(t, "unique", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+		assert.NotNil(t, v.Check)
+	})
+
+	errorCtx := context.WithValue(context.Background(), testCheckerKey{}, true)
+	cases := []struct {
+		ctx            context.Context
+		value          any
+		desc           string
+		expectedErrors []string
+		valid          bool
+		expected       bool
+	}{
+		{desc: "not_found", ctx: context.Background(), value: "johndoe", valid: true, expected: true, expectedErrors: []string{}},
+		{desc: "found", ctx: context.Background(), value: "taken", valid: true, expected: false, expectedErrors: []string{}},
+		{desc: "error", ctx: errorCtx, value: "johndoe", valid: true, expected: false, expectedErrors: []string{"connection lost"}},
+		{desc: "ctx_invalid", ctx: errorCtx, value: "taken", valid: false, expected: true, expectedErrors: []string{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			v := UniqueFunc(stubChecker("taken"))
+			ctx := &Context{
+				Context: c.ctx,
+				Invalid: !c.valid,
+				Value:   c.value,
+			}
+			assert.Equal(t, c.expected, v.Validate(ctx))
+			assert.Equal(t, c.expectedErrors, lo.Map(ctx.errors, func(e error, _ int) string { return e.Error() }))
+		})
+	}
+}
+
+func TestExistsFuncValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := ExistsFunc(stubChecker())
+		assert.NotNil(t, v)
+		assert.Equal(t, "exists", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+		assert.NotNil(t, v.Check)
+	})
+
+	errorCtx := context.WithValue(context.Background(), testCheckerKey{}, true)
+	cases := []struct {
+		ctx            context.Context
+		value          any
+		desc           string
+		expectedErrors []string
+		valid          bool
+		expected       bool
+	}{
+		{desc: "found", ctx: context.Background(), value: 1, valid: true, expected: true, expectedErrors: []string{}},
+		{desc: "not_found", ctx: context.Background(), value: 2, valid: true, expected: false, expectedErrors: []string{}},
+		{desc: "error", ctx: errorCtx, value: 1, valid: true, expected: false, expectedErrors: []string{"connection lost"}},
+		{desc: "ctx_invalid", ctx: errorCtx, value: 2, valid: false, expected: true, expectedErrors: []string{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			v := ExistsFunc(stubChecker(1))
+			ctx := &Context{
+				Context: c.ctx,
+				Invalid: !c.valid,
+				Value:   c.value,
+			}
+			assert.Equal(t, c.expected, v.Validate(ctx))
+			assert.Equal(t, c.expectedErrors, lo.Map(ctx.errors, func(e error, _ int) string { return e.Error() }))
+		})
+	}
+
+	t.Run("Validate", func(t *testing.T) {
+		opts := &Options{
+			Data: map[string]any{"product": 2, "user": "taken"},
+			Rules: RuleSet{
+				{Path: "product", Rules: List{Required(), Int(), ExistsFunc(stubChecker(1))}},
+				{Path: "user", Rules: List{Required(), String(), UniqueFunc(stubChecker("taken"))}},
+			},
+			Language: lang.New().GetDefault(),
+		}
+		validationErrors, errs := Validate(opts)
+		require.Empty(t, errs)
+		require.NotNil(t, validationErrors)
+		assert.Equal(t, []string{"The product does not exist."}, validationErrors.Fields["product"].Errors)
+		assert.Equal(t, []string{"The user has already been taken."}, validationErrors.Fields["user"].Errors)
+
+		opts.Context = errorCtx
+		validationErrors, errs = Validate(opts)
+		require.Len(t, errs, 2)
+		assert.Nil(t, validationErrors)
+	})
+}
+
+func TestBatchExistsValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := BatchExists(func(_ context.Context, _ []any) (map[any]bool, error) { return nil, nil })
+		assert.NotNil(t, v)
+		assert.Equal(t, "exists", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+		assert.NotNil(t, v.Check)
+	})
+
+	newChecker := func(calls *[][]any, existing ...any) BatchCheckFunc {
+		return func(ctx context.Context, values []any) (map[any]bool, error) {
+			*calls = append(*calls, values)
+			if ctx != nil && ctx.Value(testCheckerKey{}) != nil {
+				return nil, fmt.Errorf("connection lost")
+			}
+			found := map[any]bool{}
+			for _, val := range values {
+				if lo.Contains(existing, val) {
+					found[val] = true
+				}
+			}
+			return found, nil
+		}
+	}
+
+	cases := []struct {
+		ctx            context.Context
+		value          any
+		desc           string
+		expectedCalls  [][]any
+		expectedErrors []string
+		expectedIndex  []int
+		valid          bool
+		expected       bool
+	}{
+		{desc: "all_exist", ctx: context.Background(), value: []int{1, 2, 3}, valid: true, expected: true, expectedCalls: [][]any{{1, 2, 3}}, expectedErrors: []string{}},
+		{desc: "mixed", ctx: context.Background(), value: []any{1, 4, 2, 5, 4}, valid: true, expected: true, expectedCalls: [][]any{{1, 4, 2, 5}}, expectedIndex: []int{1, 3, 4}, expectedErrors: []string{}},
+		{desc: "non_comparable", ctx: context.Background(), value: []any{1, map[string]any{"a": 1}}, valid: true, expected: true, expectedCalls: [][]any{{1}}, expectedIndex: []int{1}, expectedErrors: []string{}},
+		{desc: "empty", ctx: context.Background(), value: []int{}, valid: true, expected: true, expectedErrors: []string{}},
+		{desc: "not_array", ctx: context.Background(), value: 1, valid: true, expected: true, expectedErrors: []string{}},
+		{desc: "error", ctx: context.WithValue(context.Background(), testCheckerKey{}, true), value: []int{1, 4}, valid: true, expected: false, expectedCalls: [][]any{{1, 4}}, expectedErrors: []string{"connection lost"}},
+		{desc: "ctx_invalid", ctx: context.Background(), value: []int{4}, valid: false, expected: true, expectedErrors: []string{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			var calls [][]any
+			v := BatchExists(newChecker(&calls, 1, 2, 3))
+			ctx := &Context{
+				Context: c.ctx,
+				Invalid: !c.valid,
+				Value:   c.value,
+			}
+			assert.Equal(t, c.expected, v.Validate(ctx))
+			assert.Equal(t, c.expectedCalls, calls)
+			assert.Equal(t, c.expectedIndex, ctx.ArrayElementErrors())
+			assert.Equal(t, c.expectedErrors, lo.Map(ctx.errors, func(e error, _ int) string { return e.Error() }))
+		})
+	}
+
+	t.Run("Validate", func(t *testing.T) {
+		var calls [][]any
+		validationErrors, errs := Validate(&Options{
+			Data: map[string]any{"ids": []any{"1", "4", "2", "5"}},
+			Rules: RuleSet{
+				{Path: "ids", Rules: List{Required(), Array(), BatchExists(newChecker(&calls, 1, 2, 3))}},
+				{Path: "ids[]", Rules: List{Int()}},
+			},
+			Language: lang.New().GetDefault(),
+		})
+		require.Empty(t, errs)
+		assert.Equal(t, [][]any{{1, 4, 2, 5}}, calls)
+		assert.Equal(t, &Errors{
+			Fields: FieldsErrors{
+				"ids": &Errors{
+					Elements: ArrayErrors{
+						1: &Errors{Errors: []string{"The ids element value does not exist."}},
+						3: &Errors{Errors: []string{"The ids element value does not exist."}},
+					},
+				},
+			},
+		}, validationErrors)
+	})
+}
+
 func TestUniqueArrayValidator(t *testing.T) {
 	t.Run("Constructor", func(t *testing.T) {
 		v := UniqueArray[int]("table", "column", nil)
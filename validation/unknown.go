@@ -0,0 +1,149 @@
+package validation
+
+import (
+	"reflect"
+
+	"goyave.dev/goyave/v5/lang"
+	"goyave.dev/goyave/v5/util/walk"
+)
+
+// fieldTree represents the structure of the data described by a set of rules.
+// It is used to identify the fields that are not covered by the rules.
+type fieldTree struct {
+	children map[string]*fieldTree
+	wildcard *fieldTree
+	elements *fieldTree
+}
+
+func (t *fieldTree) child(name string) *fieldTree {
+	if t.children == nil {
+		t.children = make(map[string]*fieldTree)
+	}
+	child, ok := t.children[name]
+	if !ok {
+		child = &fieldTree{}
+		t.children[name] = child
+	}
+	return child
+}
+
+func (t *fieldTree) isLeaf() bool {
+	return t.children == nil && t.wildcard == nil
+}
+
+// newFieldTree creates the tree of all the fields described by the given rules.
+func newFieldTree(rules Rules) *fieldTree {
+	root := &fieldTree{}
+	for _, field := range rules {
+		node := root
+		for step := field.Path; step != nil; step = step.Next {
+			if step.Name != nil && *step.Name != CurrentElement {
+				if step.IsWildcard() {
+					if node.wildcard == nil {
+						node.wildcard = &fieldTree{}
+					}
+					node = node.wildcard
+				} else {
+					node = node.child(*step.Name)
+				}
+			}
+			if step.Type == walk.PathTypeArray {
+				if node.elements == nil {
+					node.elements = &fieldTree{}
+				}
+				node = node.elements
+			}
+		}
+		for elements := field.Elements; elements != nil; elements = elements.Elements {
+			if node.elements == nil {
+				node.elements = &fieldTree{}
+			}
+			node = node.elements
+		}
+	}
+	return root
+}
+
+// pathSegment is either an object key or an array index.
+type pathSegment struct {
+	name  *string
+	index int
+}
+
+// forEachUnknownField calls the given function for each key of the objects in the given data
+// that is not covered by the given tree. Objects matching a leaf of the tree are not explored,
+// meaning their content is considered known.
+// The path given to the function identifies the unknown field and can be used to add an error
+// to an `*Errors` bag.
+func (t *fieldTree) forEachUnknownField(data any, f func(object map[string]any, key string, path *walk.Path)) {
+	t.walkUnknownFields(data, make([]pathSegment, 0, 5), f)
+}
+
+func (t *fieldTree) walkUnknownFields(data any, segments []pathSegment, f func(object map[string]any, key string, path *walk.Path)) {
+	if object, ok := data.(map[string]any); ok {
+		if t.isLeaf() {
+			return
+		}
+		for key, value := range object {
+			child := t.wildcard
+			if c, ok := t.children[key]; ok {
+				child = c
+			}
+			s := append(segments, pathSegment{name: &key})
+			if child == nil {
+				f(object, key, makeErrorPath(s))
+				continue
+			}
+			child.walkUnknownFields(value, s, f)
+		}
+		return
+	}
+
+	elements := t.elements
+	if elements == nil {
+		// Wildcards also match array elements
+		elements = t.wildcard
+	}
+	if elements == nil {
+		return
+	}
+	if list := reflect.ValueOf(data); list.Kind() == reflect.Slice {
+		for i := 0; i < list.Len(); i++ {
+			elements.walkUnknownFields(list.Index(i).Interface(), append(segments, pathSegment{index: i}), f)
+		}
+	}
+}
+
+// makeErrorPath converts the given segments to a path that can be used with `*Errors.Add()`.
+func makeErrorPath(segments []pathSegment) *walk.Path {
+	root := &walk.Path{Type: walk.PathTypeElement}
+	step := root
+	for _, segment := range segments {
+		next := &walk.Path{Type: walk.PathTypeElement}
+		if segment.name != nil {
+			step.Type = walk.PathTypeObject
+			next.Name = segment.name
+		} else {
+			step.Type = walk.PathTypeArray
+			step.Index = &segment.index
+		}
+		step.Next = next
+		step = next
+	}
+	return root
+}
+
+// stripUnknownFields removes all the fields of the given data that are not covered by the given rules.
+func stripUnknownFields(data any, rules Rules) {
+	newFieldTree(rules).forEachUnknownField(data, func(object map[string]any, key string, _ *walk.Path) {
+		delete(object, key)
+	})
+}
+
+// rejectUnknownFields adds an error to the given bag for each field of the given data
+// that is not covered by the given rules.
+func rejectUnknownFields(data any, rules Rules, language *lang.Language, errs *Errors) {
+	newFieldTree(rules).forEachUnknownField(data, func(_ map[string]any, key string, path *walk.Path) {
+		errs.Add(path, language.Get("validation.rules.unknown_field", ":field", GetFieldName(language, &walk.Path{Name: &key})))
+	})
+}
@@ -0,0 +1,202 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"goyave.dev/goyave/v5/lang"
+)
+
+func TestStripUnknown(t *testing.T) {
+	cases := []struct {
+		data  any
+		want  any
+		rules RuleSet
+		desc  string
+	}{
+		{
+			desc:  "top_level",
+			data:  map[string]any{"name": "a", "is_admin": true},
+			rules: RuleSet{{Path: "name", Rules: List{String()}}},
+			want:  map[string]any{"name": "a"},
+		},
+		{
+			desc: "nested_object",
+			data: map[string]any{"user": map[string]any{"name": "a", "is_admin": true}, "other": 1},
+			rules: RuleSet{
+				{Path: "user", Rules: List{Object()}},
+				{Path: "user.name", Rules: List{String()}},
+			},
+			want: map[string]any{"user": map[string]any{"name": "a"}},
+		},
+		{
+			desc: "object_without_property_rules",
+			data: map[string]any{"meta": map[string]any{"a": 1, "b": 2}},
+			rules: RuleSet{
+				{Path: "meta", Rules: List{Object()}},
+			},
+			want: map[string]any{"meta": map[string]any{"a": 1, "b": 2}},
+		},
+		{
+			desc: "array_of_objects",
+			data: map[string]any{"items": []any{
+				map[string]any{"id": 1, "price": 2},
+				map[string]any{"id": 2, "price": 3},
+			}},
+			rules: RuleSet{
+				{Path: "items", Rules: List{Array()}},
+				{Path: "items[]", Rules: List{Object()}},
+				{Path: "items[].id", Rules: List{Int()}},
+			},
+			want: map[string]any{"items": []map[string]any{
+				{"id": 1},
+				{"id": 2},
+			}},
+		},
+		{
+			desc: "n-dimensional_array",
+			data: map[string]any{"matrix": []any{[]any{map[string]any{"a": 1, "b": 2}}}},
+			rules: RuleSet{
+				{Path: "matrix", Rules: List{Array()}},
+				{Path: "matrix[]", Rules: List{Array()}},
+				{Path: "matrix[][].a", Rules: List{Int()}},
+			},
+			want: map[string]any{"matrix": [][]map[string]any{{{"a": 1}}}},
+		},
+		{
+			desc: "wildcard",
+			data: map[string]any{"prices": map[string]any{"a": map[string]any{"amount": 1, "other": 2}}},
+			rules: RuleSet{
+				{Path: "prices", Rules: List{Object()}},
+				{Path: "prices.*", Rules: List{Object()}},
+				{Path: "prices.*.amount", Rules: List{Int()}},
+			},
+			want: map[string]any{"prices": map[string]any{"a": map[string]any{"amount": 1}}},
+		},
+		{
+			desc: "composition",
+			data: map[string]any{"user": map[string]any{"name": "a", "is_admin": true}},
+			rules: RuleSet{
+				{Path: "user", Rules: RuleSet{
+					{Path: CurrentElement, Rules: List{Object()}},
+					{Path: "name", Rules: List{String()}},
+				}},
+			},
+			want: map[string]any{"user": map[string]any{"name": "a"}},
+		},
+		{
+			desc:  "root_rules_only",
+			data:  map[string]any{"a": 1},
+			rules: RuleSet{{Path: CurrentElement, Rules: List{Object()}}},
+			want:  map[string]any{"a": 1},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			opts := &Options{Data: c.data, Rules: c.rules, StripUnknown: true}
+			errs, err := Validate(opts)
+			require.Empty(t, err)
+			assert.Nil(t, errs)
+			assert.Equal(t, c.want, opts.Data)
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		opts := &Options{
+			Data:  map[string]any{"name": "a", "is_admin": true},
+			Rules: RuleSet{{Path: "name", Rules: List{String()}}},
+		}
+		errs, err := Validate(opts)
+		require.Empty(t, err)
+		assert.Nil(t, errs)
+		assert.Equal(t, map[string]any{"name": "a", "is_admin": true}, opts.Data)
+	})
+}
+
+func TestRejectUnknown(t *testing.T) {
+	rules := func() RuleSet {
+		return RuleSet{
+			{Path: "name", Rules: List{Required(), String()}},
+			{Path: "user", Rules: List{Object()}},
+			{Path: "user.email", Rules: List{String()}},
+			{Path: "items", Rules: List{Array()}},
+			{Path: "items[]", Rules: List{Object()}},
+			{Path: "items[].id", Rules: List{Int()}},
+		}
+	}
+
+	t.Run("unknown_fields", func(t *testing.T) {
+		opts := &Options{
+			Data: map[string]any{
+				"name":     "a",
+				"is_admin": true,
+				"user":     map[string]any{"email": "a@b.c", "role": "admin"},
+				"items":    []any{map[string]any{"id": 1}, map[string]any{"id": 2, "price": 0}},
+				"a.b":      1,
+			},
+			Rules:         rules(),
+			RejectUnknown: true,
+			Language:      lang.New().GetDefault(),
+		}
+		errs, err := Validate(opts)
+		require.Empty(t, err)
+		require.NotNil(t, errs)
+
+		want := &Errors{
+			Fields: FieldsErrors{
+				"is_admin": {Errors: []string{"The is_admin is not allowed."}},
+				"a.b":      {Errors: []string{"The a.b is not allowed."}},
+				"user": {Fields: FieldsErrors{
+					"role": {Errors: []string{"The role is not allowed."}},
+				}},
+				"items": {Elements: ArrayErrors{
+					1: {Fields: FieldsErrors{
+						"price": {Errors: []string{"The price is not allowed."}},
+					}},
+				}},
+			},
+		}
+		assert.Equal(t, want, errs)
+
+		// Data is not modified
+		assert.Contains(t, opts.Data, "is_admin")
+	})
+
+	t.Run("merged_with_validation_errors", func(t *testing.T) {
+		errs, err := Validate(&Options{
+			Data:          map[string]any{"name": 1, "other": 1},
+			Rules:         rules(),
+			RejectUnknown: true,
+			Language:      lang.New().GetDefault(),
+		})
+		require.Empty(t, err)
+		require.NotNil(t, errs)
+		assert.Equal(t, []string{"The other is not allowed."}, errs.Fields["other"].Errors)
+		assert.Equal(t, []string{"The name must be a string."}, errs.Fields["name"].Errors)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		errs, err := Validate(&Options{
+			Data:     map[string]any{"name": "a", "other": 1},
+			Rules:    rules(),
+			Language: lang.New().GetDefault(),
+		})
+		require.Empty(t, err)
+		assert.Nil(t, errs)
+	})
+
+	t.Run("strip_takes_precedence", func(t *testing.T) {
+		opts := &Options{
+			Data:          map[string]any{"name": "a", "other": 1},
+			Rules:         rules(),
+			RejectUnknown: true,
+			StripUnknown:  true,
+		}
+		errs, err := Validate(opts)
+		require.Empty(t, err)
+		assert.Nil(t, errs)
+		assert.Equal(t, map[string]any{"name": "a"}, opts.Data)
+	})
+}
@@ -1,26 +1,92 @@
 package validation
 
-import "net/url"
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// DefaultResolveTimeout the maximum duration of a host name resolution
+// made by `URLValidator` if its `ResolveTimeout` is not set.
+const DefaultResolveTimeout = 2 * time.Second
+
+// IPResolver resolves host names to IP addresses. `*net.Resolver` implements this interface.
+type IPResolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
 
 // URLValidator the field under validation must be a string representing
 // a valid URL as per `url.ParseRequestURI()`.
 // If validation passes, the value is converted to `*url.URL`.
-type URLValidator struct{ BaseValidator }
+//
+// If `PublicOnly` is true, URLs whose host is not public are rejected (see `IsPublicIP()`).
+// Without a `Resolver`, only IP literals and "localhost" host names are checked. With a `Resolver`,
+// host names are resolved and the URL is rejected if the resolution fails or
+// if any of the resolved addresses is not public. The resolution is bounded by `ResolveTimeout`.
+type URLValidator struct {
+	BaseValidator
+	Resolver       IPResolver
+	ResolveTimeout time.Duration
+	PublicOnly     bool
+}
 
 // Validate checks the field under validation satisfies this validator's criteria.
 func (v *URLValidator) Validate(ctx *Context) bool {
-	if _, ok := ctx.Value.(*url.URL); ok {
-		return true
-	}
-	val, ok := ctx.Value.(string)
+	u, ok := ctx.Value.(*url.URL)
 	if !ok {
+		val, ok := ctx.Value.(string)
+		if !ok {
+			return false
+		}
+		var err error
+		u, err = url.ParseRequestURI(val)
+		if err != nil {
+			return false
+		}
+	}
+	if v.PublicOnly && !v.isPublicHost(ctx.Context, u.Hostname()) {
 		return false
 	}
-	url, err := url.ParseRequestURI(val)
-	if err != nil {
+	ctx.Value = u
+	return true
+}
+
+func (v *URLValidator) isPublicHost(ctx context.Context, host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "" {
+		return false
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return IsPublicIP(ip)
+	}
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	if v.Resolver == nil {
+		return true
+	}
+
+	timeout := v.ResolveTimeout
+	if timeout <= 0 {
+		timeout = DefaultResolveTimeout
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	addrs, err := v.Resolver.LookupIPAddr(ctx, host)
+	if err != nil || len(addrs) == 0 {
 		return false
 	}
-	ctx.Value = url
+	for _, addr := range addrs {
+		if !IsPublicIP(addr.IP) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -33,6 +99,135 @@ func (v *URLValidator) IsType() bool { return true }
 // URL the field under validation must be a representing
 // a valid URL as per `url.ParseRequestURI()`.
 // If validation passes, the value is converted to `*url.URL`.
+//
+// To prevent server-side request forgery (SSRF), set the `PublicOnly` field of the
+// returned validator to true to reject URLs pointing to private, loopback, link-local or
+// unique-local addresses. Set its `Resolver` field (e.g. to `net.DefaultResolver`) to also
+// check the addresses host names resolve to. Note that the host could resolve to a different address
+// when the URL is actually requested (DNS rebinding): this validator doesn't replace
+// a check of the address the HTTP client connects to.
 func URL() *URLValidator {
 	return &URLValidator{}
 }
+
+//------------------------------
+
+// URLHostInValidator the field under validation must be a string representing
+// a valid URL as per `url.ParseRequestURI()` whose host is in the allow-list.
+// If validation passes, the value is converted to `*url.URL`.
+type URLHostInValidator struct {
+	BaseValidator
+	Hosts []string
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *URLHostInValidator) Validate(ctx *Context) bool {
+	u, ok := ctx.Value.(*url.URL)
+	if !ok {
+		val, ok := ctx.Value.(string)
+		if !ok {
+			return false
+		}
+		var err error
+		u, err = url.ParseRequestURI(val)
+		if err != nil {
+			return false
+		}
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "" || !isHostAllowed(v.Hosts, host) {
+		return false
+	}
+	ctx.Value = u
+	return true
+}
+
+// isHostAllowed returns true if the given lower-case host matches one of the allowed hosts.
+// See `URLHostIn()`.
+func isHostAllowed(hosts []string, host string) bool {
+	for _, allowed := range hosts {
+		allowed = strings.ToLower(allowed)
+		if suffix, ok := strings.CutPrefix(allowed, "*"); ok {
+			if strings.HasPrefix(suffix, ".") && strings.HasSuffix(host, suffix) && len(host) > len(suffix) {
+				return true
+			}
+			continue
+		}
+		if host == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// Name returns the string name of the validator.
+func (v *URLHostInValidator) Name() string { return "url" }
+
+// IsType returns true.
+func (v *URLHostInValidator) IsType() bool { return true }
+
+// URLHostIn the field under validation must be a string representing
+// a valid URL as per `url.ParseRequestURI()` whose host is one of the given hosts.
+// This is useful to prevent server-side request forgery (SSRF) to internal hosts when
+// the server makes requests to a user-provided URL (webhooks for example).
+// Hosts are compared case-insensitively and the port is ignored. A host starting
+// with "*." matches any subdomain of the given domain, but not the domain itself:
+// "*.example.com" matches "api.example.com" but not "example.com".
+// If validation passes, the value is converted to `*url.URL`.
+//
+// This validator uses the same error message as `URL()`. Use `WithMessage()` to use
+// a more specific message.
+func URLHostIn(hosts ...string) *URLHostInValidator {
+	return &URLHostInValidator{Hosts: hosts}
+}
+
+//------------------------------
+
+// SafeRedirectValidator the field under validation must be a string representing
+// either a relative URL or an absolute HTTP(S) URL whose host is in the allow-list.
+// Scheme-relative URLs (e.g. "//evil.com") and URLs containing backslashes or
+// control characters are rejected.
+type SafeRedirectValidator struct {
+	BaseValidator
+	Hosts []string
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *SafeRedirectValidator) Validate(ctx *Context) bool {
+	val, ok := ctx.Value.(string)
+	if !ok || val == "" {
+		return false
+	}
+
+	// Browsers treat backslashes as slashes and ignore some control characters,
+	// which can turn "/\evil.com" or "/\t/evil.com" into a scheme-relative URL.
+	if strings.ContainsFunc(val, func(r rune) bool { return r == '\\' || unicode.IsControl(r) || unicode.IsSpace(r) }) {
+		return false
+	}
+	u, err := url.Parse(val)
+	if err != nil {
+		return false
+	}
+	if u.Scheme == "" {
+		return u.Host == "" && u.User == nil && !strings.HasPrefix(val, "//")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.User != nil {
+		return false
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	return host != "" && isHostAllowed(v.Hosts, host)
+}
+
+// Name returns the string name of the validator.
+func (v *SafeRedirectValidator) Name() string { return "safe_redirect" }
+
+// SafeRedirect the field under validation must be a string representing either a relative
+// URL (e.g. "/dashboard?tab=1") or an absolute HTTP(S) URL whose host is one of the given hosts.
+// This is useful to prevent open redirects when redirecting to a user-provided URL
+// (after login for example).
+// Scheme-relative URLs (e.g. "//evil.com"), URLs containing credentials, backslashes,
+// whitespace or control characters are rejected. Hosts are matched the same way as `URLHostIn()`.
+func SafeRedirect(allowedHosts ...string) *SafeRedirectValidator {
+	return &SafeRedirectValidator{Hosts: allowedHosts}
+}
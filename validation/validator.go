@@ -2,10 +2,15 @@ package validation
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"reflect"
 	"strings"
+	"sync/atomic"
 	"time"
 
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 	"goyave.dev/goyave/v5/config"
 	"goyave.dev/goyave/v5/lang"
@@ -103,6 +108,10 @@ func (c *component) Logger() *slog.Logger {
 // to provide values for all the options in case a `Validator` requires them to function.
 type Options struct {
 	// Context defaults to `context.Background()` if not provided.
+	// It is given to the validators through `Context.Context` so long-running validators
+	// (using the database or the network) can abort when it is cancelled. If the context is
+	// cancelled before the validation is over, the remaining fields and validators
+	// are not executed and the context's error is returned.
 	Context context.Context
 	Data    any
 	Rules   Ruler
@@ -136,7 +145,91 @@ type Options struct {
 	// If set to `true` and `field` has the `Array` rule:
 	//  field=A         --> map[string]any{"field": []string{"A"}}
 	//  field=A&field=B --> map[string]any{"field": []string{"A", "B"}}
+	//
+	// This applies to `multipart/form-data` requests as well: single text fields are
+	// converted the same way. File fields are always `[]fsutil.File`, so they are left untouched.
 	ConvertSingleValueArrays bool
+
+	// SkipTypeDependentOnTypeError set to true to skip the type-dependent validators
+	// (such as `Min()` or `Max()`) of a field if one of its type validators (such as `Int()`) failed.
+	// This way, only the type error is reported instead of irrelevant messages.
+	SkipTypeDependentOnTypeError bool
+
+	// RecoverPanics set to true to recover from panics raised by validators. The panic
+	// is converted to an error returned by `Validate()` (second returned value) and the field
+	// is considered invalid. The other fields are still validated.
+	//
+	// Leave this option disabled while debugging to get the full panic trace.
+	RecoverPanics bool
+
+	// StripUnknown set to true to remove all the fields of the data that are not covered by
+	// the rules before validating. This prevents mass-assignment. Objects are stripped recursively
+	// only if there are rules for their properties: an object field validated with `Object()` but without
+	// rules for its properties is kept untouched.
+	StripUnknown bool
+
+	// RejectUnknown set to true to add a validation error for each field of the data
+	// that is not covered by the rules. Like `StripUnknown`, objects are only checked
+	// recursively if there are rules for their properties. If both options are enabled,
+	// `StripUnknown` takes precedence.
+	RejectUnknown bool
+
+	// Cache if not nil, validation results are memoized in this cache and re-used
+	// when the same data is validated again with the same rule set. See `ResultCache`.
+	Cache *ResultCache
+
+	// MaxErrors if greater than zero, caps the number of validation error messages
+	// collected. Once the limit is reached, the validation stops early and the remaining
+	// fields and validators are not executed, so the data may be only partially converted.
+	// This protects against payloads crafted to generate a huge amount of errors (a large
+	// array whose elements are all invalid for example). Warnings are not counted.
+	// Zero means unlimited.
+	//
+	// If `Concurrency` is enabled, which errors are kept when the limit is reached is not deterministic.
+	MaxErrors int
+
+	// Concurrency if greater than 1, the independent top-level fields are validated concurrently
+	// by a pool of at most `Concurrency` workers. This is useful for rule sets containing
+	// slow validators (using the database or the network for example).
+	//
+	// A top-level field is independent if none of its validators (including those of its
+	// nested fields and elements) read other fields. Validators comparing the field to
+	// another one (such as `Same()` or `GreaterThan()`), `RequiredIf()` and `OnlyIf()` are
+	// considered cross-field, as well as validators implementing `CrossFieldValidator`.
+	// The fields that are not independent are validated sequentially after all the independent
+	// fields, so they can rely on the converted values. Independent fields only have access to their
+	// own value: `Context.Data` only contains the top-level field under validation.
+	//
+	// The validation errors are merged in the order of the rules, so the result
+	// is deterministic. `Extra` is shared by all the workers, so validators must not modify it.
+	// This option has no effect if `Data` is not a `map[string]any`.
+	Concurrency int
+
+	// OnRuleComplete if not nil, is called after each validator execution with the path of
+	// the field under validation (e.g. "array[2].field"), the name of the validator, whether
+	// it passed or not and how long it took. This can be used for metrics, to find slow validators.
+	// A validator that returned errors (see `Context.AddError()`) is considered as not passed.
+	// If `Concurrency` is enabled, this function may be called concurrently.
+	OnRuleComplete func(field, rule string, passed bool, duration time.Duration)
+
+	// Request the HTTP request being validated, if any. It is given to the validators
+	// through `Context.Request` so they can access the request metadata (headers, remote address, etc).
+	// This option is set automatically when validating a request.
+	Request *http.Request
+
+	// Method the HTTP method of the request being validated (e.g. "POST"), if any.
+	// It is given to the validators through `Context.Method` and is used by `ForMethod()`.
+	// Defaults to the method of `Request` if not provided.
+	// This option is set automatically when validating a request.
+	Method string
+
+	// NoMutation set to true to validate a deep copy of `Data` instead of the original.
+	// Maps and slices are copied recursively, other values are shared. Once the validation is over, `Data` is left unchanged: the values converted by
+	// the validators (such as `Int()` or `Date()`) and the fields removed by `StripUnknown`
+	// are discarded. This trades the converted data for immutability, which is useful when
+	// the same input must be kept as-is (to be validated again with other rules for example).
+	// During the validation, validators still see the values converted by the previous validators.
+	NoMutation bool
 }
 
 type addedValidationErrorConstraint interface {
@@ -173,6 +266,14 @@ type Context struct {
 	// The name of the field under validation
 	Name string
 
+	// Method the HTTP method of the request being validated (see `Options.Method`).
+	// Empty if the data doesn't come from a request. This field is readonly.
+	Method string
+
+	// Request the HTTP request being validated (see `Options.Request`).
+	// `nil` if the data doesn't come from a request. This field is readonly.
+	Request *http.Request
+
 	errors []error
 
 	// Invalid is true if at least one validator prior to the current one didn't pass
@@ -242,11 +343,38 @@ func (c *Context) AddedValidationErrors() []AddedValidationError[*Errors] {
 // The path is relative to the root element. If you are compositing rule sets in your validation,
 // the path returned is NOT relative to the root of the current rule set.
 //
+// Array indexes are included: for a field "array[].field", the path of the element
+// at index 2 is "array[2].field" (see `walk.Path.String()`). This is useful for building
+// error messages or adding context to logs.
+//
 // You can use this path to inject validation errors using AddValidationError and MergeValidationErrors.
 func (c *Context) Path() *walk.Path {
 	return c.path
 }
 
+// Lookup returns the value of the element identified by the given path.
+// The path is relative to `Context.Data`, so it can be used to easily read sibling or
+// parent fields. If the path contains wildcards or arrays, only the first matching
+// element is returned.
+//
+// The second returned value is `false` if the element could not be found or if
+// the given path is invalid.
+func (c *Context) Lookup(path string) (any, bool) {
+	p, err := walk.Parse(path)
+	if err != nil {
+		return nil, false
+	}
+	return p.Lookup(c.Data)
+}
+
+// ParentObject returns the parent of the field under validation if it is an object.
+// Returns `nil` if the parent is not an object (for example if the field under
+// validation is an array element).
+func (c *Context) ParentObject() map[string]any {
+	parent, _ := c.Parent.(map[string]any)
+	return parent
+}
+
 // Errors returns this validation context's errors.
 // The errors returned are NOT validation errors but operation errors (such as database error).
 // Because each rule on each field has its own Context, the returned array will only contain
@@ -257,8 +385,10 @@ func (c *Context) Errors() []error {
 
 type validator struct {
 	validationErrors *Errors
+	warnings         *Errors
 	options          *Options
 	now              time.Time
+	errorCount       *atomic.Int64
 	errors           []error
 }
 
@@ -271,11 +401,22 @@ type validator struct {
 //
 // The `Options.Data` may be modified thanks to type rules.
 func Validate(options *Options) (*Errors, []error) {
+	validationErrors, _, errs := ValidateWithWarnings(options)
+	return validationErrors, errs
+}
+
+// ValidateWithWarnings works like `Validate()` but also returns the messages of the
+// failed validators marked with `Warn()`. Warnings don't fail the validation: if
+// only warning validators failed, the first returned value is `nil`.
+// If there are no warnings, the second returned value is `nil`.
+func ValidateWithWarnings(options *Options) (validationErrors *Errors, warnings *Errors, errs []error) {
 	validator := &validator{
 		options:          options,
 		now:              options.Now,
 		errors:           []error{},
 		validationErrors: &Errors{},
+		warnings:         &Errors{},
+		errorCount:       &atomic.Int64{},
 	}
 	if validator.now.IsZero() {
 		validator.now = time.Now()
@@ -289,43 +430,115 @@ func Validate(options *Options) (*Errors, []error) {
 	if options.Context == nil {
 		options.Context = context.Background()
 	}
+	if options.Method == "" && options.Request != nil {
+		options.Method = options.Request.Method
+	}
+	if options.NoMutation {
+		data := options.Data
+		options.Data = cloneData(data)
+		defer func() {
+			options.Data = data
+		}()
+	}
 
 	rules := options.Rules.AsRules()
+	if options.Logger != nil {
+		warnDeprecatedRules(rules, options.Logger)
+	}
+
+	if options.StripUnknown {
+		stripUnknownFields(options.Data, rules)
+	} else if options.RejectUnknown {
+		rejectUnknownFields(options.Data, rules, options.Language, validator.validationErrors)
+	}
+
+	var key cacheKey
+	cacheable := false
+	if options.Cache != nil {
+		key, cacheable = resultCacheKey(rules, options)
+	}
+	if cacheable {
+		if entry, ok := options.Cache.get(key); ok {
+			options.Data = cloneData(entry.data)
+			return entry.validationErrors, entry.warnings, nil
+		}
+	}
+
+	if options.Concurrency > 1 {
+		validator.validateConcurrently(rules)
+	} else {
+		validator.validateRules(rules)
+	}
+
+	if err := options.Context.Err(); err != nil {
+		validator.errors = append(validator.errors, errors.New(fmt.Errorf("validation: %w", err)))
+	}
+	if len(validator.errors) != 0 {
+		return nil, nil, validator.errors
+	}
+	if !validator.warnings.isEmpty() {
+		warnings = validator.warnings
+	}
+	if !validator.validationErrors.isEmpty() {
+		validationErrors = validator.validationErrors
+	}
+	if cacheable {
+		options.Cache.put(&cacheEntry{
+			key:              key,
+			data:             cloneData(options.Data),
+			validationErrors: validationErrors,
+			warnings:         warnings,
+		})
+	}
+	return validationErrors, warnings, nil
+}
+
+func (v *validator) validateRules(rules Rules) {
 	for _, field := range rules {
+		if v.shouldStop() {
+			break
+		}
 		if field.Path.Name != nil && *field.Path.Name == CurrentElement {
 			// Validate the root element
 			fakeParent := map[string]any{}
-			if options.Data != nil {
-				fakeParent[CurrentElement] = options.Data
+			if v.options.Data != nil {
+				fakeParent[CurrentElement] = v.options.Data
 			}
-			validator.validateField(*field.Path.Name, field, fakeParent, nil)
-			options.Data = fakeParent[CurrentElement]
+			v.validateField(*field.Path.Name, field, fakeParent, nil)
+			v.options.Data = fakeParent[CurrentElement]
 		} else {
-			validator.validateField(field.Path.Tail().String(), field, options.Data, nil)
+			v.validateField(field.Path.Tail().String(), field, v.options.Data, nil)
 		}
 	}
-
-	if len(validator.errors) != 0 {
-		return nil, validator.errors
-	}
-	if len(validator.validationErrors.Errors) != 0 || len(validator.validationErrors.Elements) != 0 || len(validator.validationErrors.Fields) != 0 {
-		return validator.validationErrors, nil
-	}
-	return nil, nil
 }
 
 func (v *validator) validateField(fieldName string, field *Field, walkData any, parentPath *walk.Path) {
+	v.walkField(fieldName, field, walkData, parentPath, false, v.validateElement)
+}
+
+// walkField walks the data matched by the given field's path and calls the given function
+// for each matched element that is not absent. Nil values are removed from their
+// parent object if the field is not nullable and single values are converted to arrays (if enabled).
+// If the field has an `Elements` field, its elements are walked first.
+//
+// If "dryRun" is true, the data is never modified: the conversions only affect the
+// value given to the function.
+func (v *validator) walkField(fieldName string, field *Field, walkData any, parentPath *walk.Path, dryRun bool, f func(fieldName string, field *Field, c *walk.Context, parentPath *walk.Path, shouldDeleteFromParent bool)) {
 	field.Path.Walk(walkData, func(c *walk.Context) {
 		parentObject, parentIsObject := c.Parent.(map[string]any)
 		shouldDeleteFromParent := v.shouldDeleteFromParent(field, parentIsObject, c.Value)
 		if c.Found == walk.Found {
 			if shouldDeleteFromParent {
-				delete(parentObject, c.Name)
+				if !dryRun {
+					delete(parentObject, c.Name)
+				}
 				c.Found = walk.ElementNotFound
 			} else {
 				if v.shouldConvertSingleValueArray(fieldName) {
 					c.Value = v.convertSingleValueArray(field, c.Value)
-					parentObject[c.Name] = c.Value
+					if !dryRun {
+						parentObject[c.Name] = c.Value
+					}
 				}
 			}
 		}
@@ -335,8 +548,8 @@ func (v *validator) validateField(fieldName string, field *Field, walkData any,
 		}
 
 		if field.Elements != nil {
-			// This is an array, validate its elements first so it can be converted to correct type
-			if newValue, ok := makeGenericSlice(c.Value); ok {
+			// This is an array, process its elements first so it can be converted to correct type
+			if newValue, ok := makeGenericSlice(c.Value); ok && !dryRun {
 				replaceValue(c.Value, c)
 				c.Value = newValue
 			}
@@ -350,75 +563,146 @@ func (v *validator) validateField(fieldName string, field *Field, walkData any,
 				tail.Next = path.Next
 				path = clone
 			}
-			v.validateField(fieldName+"[]", field.Elements, c.Value, path)
+			v.walkField(fieldName+"[]", field.Elements, c.Value, path, dryRun, f)
 		}
 
-		data := v.options.Data
+		f(fieldName, field, c, parentPath, shouldDeleteFromParent)
+	})
+}
 
-		if field.prefixDepth > 0 {
-			fullPath := appendPath(parentPath, c.Path, c.Index)
-			if rootPath := fullPath.Truncate(field.prefixDepth); rootPath != nil {
-				// We can use `First` here because the path contains array indexes
-				// so we are sure there will be only one match.
-				data = rootPath.First(data).Value
-			}
+func (v *validator) validateElement(fieldName string, field *Field, c *walk.Context, parentPath *walk.Path, shouldDeleteFromParent bool) {
+	if v.shouldStop() {
+		return
+	}
+	data := v.options.Data
+
+	if field.prefixDepth > 0 {
+		fullPath := appendPath(parentPath, c.Path, c.Index)
+		if rootPath := fullPath.Truncate(field.prefixDepth); rootPath != nil {
+			// We can use `First` here because the path contains array indexes
+			// so we are sure there will be only one match.
+			data = rootPath.First(data).Value
 		}
+	}
 
-		value := c.Value
-		valid := true
-		translatedFieldName := ""
-		for _, validator := range field.Validators {
-			if _, ok := validator.(*NullableValidator); ok {
-				if value == nil {
-					break
-				}
-				continue
+	value := c.Value
+	valid := true
+	typeFailed := false
+	translatedFieldName := ""
+	for _, validator := range field.Validators {
+		if v.shouldStop() {
+			break
+		}
+		if _, ok := validator.(*NullableValidator); ok {
+			if value == nil {
+				break
 			}
+			continue
+		}
+		if typeFailed && v.options.SkipTypeDependentOnTypeError && validator.IsTypeDependent() {
+			// The value doesn't have the expected type, type-dependent validators
+			// would only produce irrelevant error messages.
+			continue
+		}
 
-			errorPath := field.getErrorPath(parentPath, c)
-			ctx := &Context{
-				Context:   v.options.Context,
-				Data:      data,
-				Extra:     v.options.Extra,
-				Value:     value,
-				Parent:    c.Parent,
-				Field:     field,
-				fieldName: fieldName,
-				Now:       v.now,
-				Name:      c.Name,
-				path:      errorPath,
-				Invalid:   !valid,
-			}
-			validator.Init(v.options)
-			ok := validator.Validate(ctx)
-			if len(ctx.errors) > 0 {
+		errorPath := field.getErrorPath(parentPath, c)
+		ctx := &Context{
+			Context:   v.options.Context,
+			Data:      data,
+			Extra:     v.options.Extra,
+			Value:     value,
+			Parent:    c.Parent,
+			Field:     field,
+			fieldName: fieldName,
+			Now:       v.now,
+			Name:      c.Name,
+			Method:    v.options.Method,
+			Request:   v.options.Request,
+			path:      errorPath,
+			Invalid:   !valid,
+		}
+		validator.Init(v.options)
+		var start time.Time
+		if v.options.OnRuleComplete != nil {
+			start = time.Now()
+		}
+		ok := v.runValidator(validator, ctx)
+		if v.options.OnRuleComplete != nil {
+			v.options.OnRuleComplete(errorPath.String(), validator.Name(), ok && len(ctx.errors) == 0, time.Since(start))
+		}
+		if len(ctx.errors) > 0 {
+			valid = false
+			v.errors = append(v.errors, ctx.errors...)
+			continue
+		}
+		errs := v.validationErrors
+		if validator.isWarning() {
+			errs = v.warnings
+		}
+		if !ok {
+			if !validator.isWarning() {
 				valid = false
-				v.errors = append(v.errors, ctx.errors...)
-				continue
 			}
-			if !ok {
-				valid = false
-				if translatedFieldName == "" {
-					translatedFieldName = translateFieldName(v.options.Language, fieldName)
-				}
-				message := v.getMessage(ctx, translatedFieldName, validator)
-				if v.isRootElement(fieldName, errorPath) {
-					v.validationErrors.Add(errorPath, message)
-				} else {
-					v.validationErrors.Add(&walk.Path{Type: walk.PathTypeObject, Next: errorPath}, message)
-				}
-				continue
+			if validator.IsType() {
+				typeFailed = true
+			}
+			if translatedFieldName == "" {
+				translatedFieldName = translateFieldName(v.options.Language, fieldName)
+			}
+			message := v.getMessage(ctx, translatedFieldName, validator)
+			if v.isRootElement(fieldName, errorPath) {
+				v.addMessage(errs, errorPath, message)
+			} else {
+				v.addMessage(errs, &walk.Path{Type: walk.PathTypeObject, Next: errorPath}, message)
 			}
+			continue
+		}
 
-			v.processAddedErrors(ctx, parentPath, c, validator)
+		v.processAddedErrors(ctx, parentPath, c, validator, errs)
 
-			value = ctx.Value
-		}
-		// Value may be modified (converting rule), replace it in the parent element
-		if !shouldDeleteFromParent {
-			replaceValue(value, c)
+		value = ctx.Value
+	}
+	// Value may be modified (converting rule), replace it in the parent element
+	if !shouldDeleteFromParent {
+		replaceValue(value, c)
+	}
+}
+
+// runValidator executes the given validator. If `Options.RecoverPanics` is enabled and
+// the validator panics, the panic is converted to an error added to the context.
+func (v *validator) runValidator(validator Validator, ctx *Context) (ok bool) {
+	if v.options.RecoverPanics {
+		defer func() {
+			if r := recover(); r != nil {
+				ctx.AddError(errors.New(fmt.Errorf("validation: validator %q panicked: %v", validator.Name(), r)))
+				ok = false
+			}
+		}()
+	}
+	return validator.Validate(ctx)
+}
+
+// addMessage adds the given message to the given errors bag. If the bag is the validation
+// errors bag, the message is not added if `Options.MaxErrors` is reached.
+func (v *validator) addMessage(errs *Errors, path *walk.Path, message string) {
+	if errs == v.validationErrors && v.options.MaxErrors > 0 {
+		if v.errorCount.Add(1) > int64(v.options.MaxErrors) {
+			return
 		}
-	})
+	}
+	errs.Add(path, message)
+}
+
+// limitReached returns true if the number of collected validation errors
+// reached `Options.MaxErrors`.
+func (v *validator) limitReached() bool {
+	return v.options.MaxErrors > 0 && v.errorCount.Load() >= int64(v.options.MaxErrors)
+}
+
+// shouldStop returns true if the validation should stop early, either because
+// `Options.MaxErrors` is reached or because the context is cancelled.
+func (v *validator) shouldStop() bool {
+	return v.limitReached() || v.options.Context.Err() != nil
 }
 
 func (v *validator) isRootElement(fieldName string, errorPath *walk.Path) bool {
@@ -463,18 +747,22 @@ func (v *validator) isAbsent(field *Field, c *walk.Context, parentPath *walk.Pat
 		Field:   field,
 		Now:     v.now,
 		Name:    c.Name,
+		Method:  v.options.Method,
+		Request: v.options.Request,
 		path:    field.getErrorPath(parentPath, c),
 		Invalid: false,
 	}
 	return c.Found == walk.ElementNotFound && !field.IsRequired(requiredCtx)
 }
 
-func (v *validator) processAddedErrors(ctx *Context, parentPath *walk.Path, c *walk.Context, validator Validator) {
+// processAddedErrors adds the validation errors added by the validator
+// using the given context to the given errors bag.
+func (v *validator) processAddedErrors(ctx *Context, parentPath *walk.Path, c *walk.Context, validator Validator, errs *Errors) {
 	for _, e := range ctx.addedValidationErrors {
-		v.validationErrors.Add(&walk.Path{Type: walk.PathTypeObject, Next: e.Path}, e.Error)
+		v.addMessage(errs, &walk.Path{Type: walk.PathTypeObject, Next: e.Path}, e.Error)
 	}
 	for _, e := range ctx.mergeErrors {
-		v.validationErrors.Merge(&walk.Path{Type: walk.PathTypeObject, Next: e.Path}, e.Error)
+		errs.Merge(&walk.Path{Type: walk.PathTypeObject, Next: e.Path}, e.Error)
 	}
 	if len(ctx.arrayElementErrors) > 0 {
 		errorPath := ctx.Field.getErrorPath(parentPath, c)
@@ -486,9 +774,9 @@ func (v *validator) processAddedErrors(ctx *Context, parentPath *walk.Path, c *w
 			elementPath.Index = &i
 			elementPath.Next = &walk.Path{Type: walk.PathTypeElement}
 			if ctx.fieldName == CurrentElement {
-				v.validationErrors.Add(elementPath, message)
+				v.addMessage(errs, elementPath, message)
 			} else {
-				v.validationErrors.Add(&walk.Path{Type: walk.PathTypeObject, Next: elementPath}, message)
+				v.addMessage(errs, &walk.Path{Type: walk.PathTypeObject, Next: elementPath}, message)
 			}
 		}
 	}
@@ -524,7 +812,24 @@ func (v *validator) getLangEntry(ctx *Context, validator Validator) string {
 }
 
 func (v *validator) processPlaceholders(ctx *Context, translatedFieldName string, validator Validator) []string {
-	return append([]string{":field", translatedFieldName}, validator.MessagePlaceholders(ctx)...)
+	placeholders := append([]string{":field", translatedFieldName}, validator.MessagePlaceholders(ctx)...)
+	if lister, ok := validator.(ValuesLister); ok && !hasPlaceholder(placeholders, ":values") {
+		placeholders = append(placeholders, ":values", renderValues(lister.ListValues()))
+	}
+	return placeholders
+}
+
+func hasPlaceholder(placeholders []string, placeholder string) bool {
+	for i := 0; i < len(placeholders); i += 2 {
+		if placeholders[i] == placeholder {
+			return true
+		}
+	}
+	return false
+}
+
+func renderValues(values []any) string {
+	return strings.Join(lo.Map(values, func(v any, _ int) string { return fmt.Sprintf("%v", v) }), ", ")
 }
 
 func (v *validator) getMessage(ctx *Context, translatedFieldName string, validator Validator) string {
@@ -573,6 +878,67 @@ func makeGenericSlice(original any) ([]any, bool) {
 	return newSlice, true
 }
 
+// cloneData returns a deep copy of the given data. Only maps and slices are copied
+// (including the typed ones resulting from conversions, such as `[]string`), other values
+// are returned as-is.
+func cloneData(data any) any {
+	switch d := data.(type) {
+	case map[string]any:
+		if d == nil {
+			return data
+		}
+		clone := make(map[string]any, len(d))
+		for k, v := range d {
+			clone[k] = cloneData(v)
+		}
+		return clone
+	case []any:
+		if d == nil {
+			return data
+		}
+		clone := make([]any, len(d))
+		for i, v := range d {
+			clone[i] = cloneData(v)
+		}
+		return clone
+	}
+
+	value := reflect.ValueOf(data)
+	switch value.Kind() {
+	case reflect.Slice:
+		if value.IsNil() {
+			return data
+		}
+		clone := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for i := range value.Len() {
+			clone.Index(i).Set(cloneValue(value.Index(i)))
+		}
+		return clone.Interface()
+	case reflect.Map:
+		if value.IsNil() {
+			return data
+		}
+		clone := reflect.MakeMapWithSize(value.Type(), value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			clone.SetMapIndex(iter.Key(), cloneValue(iter.Value()))
+		}
+		return clone.Interface()
+	}
+	return data
+}
+
+func cloneValue(value reflect.Value) reflect.Value {
+	if value.Kind() == reflect.Interface && value.IsNil() {
+		return value
+	}
+	clone := cloneData(value.Interface())
+	if clone == nil {
+		return reflect.Zero(value.Type())
+	}
+	return reflect.ValueOf(clone)
+}
+
 func appendPath(parentPath, childPath *walk.Path, index int) *walk.Path {
 	fullPath := childPath
 	if parentPath != nil {
@@ -592,7 +958,7 @@ func appendPath(parentPath, childPath *walk.Path, index int) *walk.Path {
 // GetFieldType returns the non-technical type of the given "value" interface.
 // This is used by validation rules to know if the input data is a candidate
 // for validation or not and is especially useful for type-dependent rules.
-//   - "numeric" (`lang.FieldTypeNumeric`) if the value is an int, uint or a float
+//   - "numeric" (`lang.FieldTypeNumeric`) if the value is an int, uint, a float or a `json.Number`
 //   - "string" (`lang.FieldTypeString`) if the value is a string
 //   - "array" (`lang.FieldTypeArray`) if the value is a slice
 //   - "file" (`lang.FieldTypeFile`) if the value is a slice of "fsutil.File"
@@ -602,7 +968,12 @@ func GetFieldType(value any) string {
 	return getFieldType(reflect.ValueOf(value))
 }
 
+var jsonNumberType = reflect.TypeOf(json.Number(""))
+
 func getFieldType(value reflect.Value) string {
+	if value.IsValid() && value.Type() == jsonNumberType {
+		return FieldTypeNumeric
+	}
 	kind := value.Kind().String()
 	switch {
 	case strings.HasPrefix(kind, "int"), strings.HasPrefix(kind, "uint") && kind != "uintptr", strings.HasPrefix(kind, "float"):
@@ -3,8 +3,14 @@ package validation
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"math"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"testing/fstest"
 	"time"
 
 	"github.com/samber/lo"
@@ -93,6 +99,72 @@ func TestContext(t *testing.T) {
 		c.AddArrayElementValidationErrors(1, 2, 3)
 		assert.Equal(t, []int{1, 2, 3}, c.arrayElementErrors)
 	})
+
+	t.Run("Path", func(t *testing.T) {
+		paths := []string{}
+		v := &testValidator{
+			validateFunc: func(_ component, ctx *Context) bool {
+				paths = append(paths, ctx.Path().String())
+				return true
+			},
+		}
+		opts := &Options{
+			Data: map[string]any{
+				"object": map[string]any{
+					"array": []any{
+						map[string]any{"field": "a"},
+						map[string]any{"field": "b"},
+					},
+				},
+			},
+			Rules: RuleSet{
+				{Path: "object", Rules: List{Object()}},
+				{Path: "object.array", Rules: List{Array()}},
+				{Path: "object.array[]", Rules: List{Object()}},
+				{Path: "object.array[].field", Rules: List{v}},
+			},
+		}
+		validationErrors, errs := Validate(opts)
+		require.Nil(t, errs)
+		require.Nil(t, validationErrors)
+		assert.Equal(t, []string{"object.array[0].field", "object.array[1].field"}, paths)
+	})
+
+	t.Run("Lookup", func(t *testing.T) {
+		var sibling any
+		var siblingFound bool
+		var parent map[string]any
+		v := &testValidator{
+			validateFunc: func(_ component, ctx *Context) bool {
+				sibling, siblingFound = ctx.Lookup("object.sibling")
+				parent = ctx.ParentObject()
+				_, notFound := ctx.Lookup("object.missing")
+				_, invalid := ctx.Lookup("invalid[path.")
+				return !notFound && !invalid
+			},
+		}
+		data := map[string]any{
+			"object": map[string]any{
+				"field":   "a",
+				"sibling": 12.3,
+			},
+		}
+		opts := &Options{
+			Data: data,
+			Rules: RuleSet{
+				{Path: "object", Rules: List{Object()}},
+				{Path: "object.field", Rules: List{v}},
+			},
+		}
+		validationErrors, errs := Validate(opts)
+		require.Nil(t, errs)
+		require.Nil(t, validationErrors)
+		assert.True(t, siblingFound)
+		assert.Equal(t, 12.3, sibling)
+		assert.Equal(t, data["object"], parent)
+
+		assert.Nil(t, (&Context{Parent: []any{"a"}}).ParentObject())
+	})
 }
 
 func TestGetFieldName(t *testing.T) {
@@ -135,6 +207,7 @@ func TestGetFieldType(t *testing.T) {
 		{desc: "numeric_uint64", value: uint64(1), want: FieldTypeNumeric},
 		{desc: "numeric_float32", value: float32(1), want: FieldTypeNumeric},
 		{desc: "numeric_float64", value: float64(1), want: FieldTypeNumeric},
+		{desc: "numeric_json_number", value: json.Number("1"), want: FieldTypeNumeric},
 		{desc: "string", value: "", want: FieldTypeString},
 		{desc: "bool", value: true, want: FieldTypeBool},
 		{desc: "slice_int", value: []int{}, want: FieldTypeArray},
@@ -1056,6 +1129,48 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestValidateConvertSingleValueArraysMultipart(t *testing.T) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	require.NoError(t, writer.WriteField("tags", "a"))
+	part, err := writer.CreateFormFile("files", "test.txt")
+	require.NoError(t, err)
+	_, err = part.Write([]byte("file content"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(math.MaxInt64 - 1)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = form.RemoveAll()
+	})
+	files, err := fsutil.ParseMultipartFiles(form.File["files"])
+	require.NoError(t, err)
+
+	// Single values are flattened like the parse middleware does
+	data := map[string]any{
+		"tags":  form.Value["tags"][0],
+		"files": files,
+	}
+	opts := &Options{
+		Data:                     data,
+		ConvertSingleValueArrays: true,
+		Language:                 lang.Default,
+		Rules: RuleSet{
+			{Path: "tags", Rules: List{Array()}},
+			{Path: "tags[]", Rules: List{String()}},
+			{Path: "files", Rules: List{File()}},
+		},
+	}
+
+	validationErrors, errs := Validate(opts)
+	require.Nil(t, errs)
+	require.Nil(t, validationErrors)
+
+	assert.Equal(t, []string{"a"}, data["tags"])
+	assert.Equal(t, files, data["files"])
+}
+
 func TestAddedErrors(t *testing.T) {
 	ctx := &Context{}
 	expectAddedErrors := []AddedValidationError[string]{
@@ -1195,3 +1310,583 @@ func TestValidateMessageOverride(t *testing.T) {
 	}
 	assert.Equal(t, want, validationErrors)
 }
+
+type testValuesValidator struct {
+	testValidator
+	values []any
+}
+
+func (v *testValuesValidator) ListValues() []any {
+	return v.values
+}
+
+func TestValidateValuesPlaceholder(t *testing.T) {
+	languages := lang.New()
+	langFS := fstest.MapFS{
+		"en-US/rules.json": {Data: []byte(`{"test_validator": "The :field must be one of: :values."}`)},
+	}
+	require.NoError(t, languages.Load(langFS, "en-US", "en-US"))
+
+	newValidator := func(placeholders func(ctx *Context) []string) *testValuesValidator {
+		return &testValuesValidator{
+			testValidator: testValidator{
+				validateFunc: func(_ component, _ *Context) bool { return false },
+				placeholders: placeholders,
+			},
+			values: []any{"a", 2, "c,d"},
+		}
+	}
+
+	cases := []struct {
+		validator Validator
+		want      string
+		desc      string
+	}{
+		{desc: "in", validator: In([]string{"a", "b", "c"}), want: "The field must have one of the following values: a, b, c."},
+		{desc: "not_in", validator: NotIn([]int{1, 2}), want: "The field must not have one of the following values: 1, 2."},
+		{desc: "default", validator: newValidator(nil), want: "The field must be one of: a, 2, c,d."},
+		{
+			desc: "override",
+			validator: newValidator(func(_ *Context) []string {
+				return []string{":values", "custom"}
+			}),
+			want: "The field must be one of: custom.",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			opts := &Options{
+				Data:     map[string]any{"field": "x"},
+				Language: languages.GetDefault(),
+				Rules: RuleSet{
+					{Path: "field", Rules: List{c.validator}},
+				},
+			}
+			validationErrors, errs := Validate(opts)
+			require.Nil(t, errs)
+			assert.Equal(t, []string{c.want}, validationErrors.Fields["field"].Errors)
+		})
+	}
+}
+
+func TestValidateWithWarnings(t *testing.T) {
+	t.Run("only_warnings", func(t *testing.T) {
+		opts := &Options{
+			Data: map[string]any{
+				"password": "short",
+				"array":    []any{"a", "bcd"},
+			},
+			Language: lang.New().GetDefault(),
+			Rules: RuleSet{
+				{Path: "password", Rules: List{Required(), String(), Warn(Min(8))}},
+				{Path: "array", Rules: List{Required(), Array()}},
+				{Path: "array[]", Rules: List{String(), Warn(Max(2))}},
+			},
+		}
+
+		validationErrors, warnings, errs := ValidateWithWarnings(opts)
+		require.Nil(t, errs)
+		assert.Nil(t, validationErrors)
+		want := &Errors{
+			Fields: FieldsErrors{
+				"password": {Errors: []string{"The password must be at least 8 characters."}},
+				"array": {
+					Elements: ArrayErrors{
+						1: {Errors: []string{"The array elements may not have more than 2 characters."}},
+					},
+				},
+			},
+		}
+		assert.Equal(t, want, warnings)
+
+		// Warnings are not returned by Validate
+		validationErrors, errs = Validate(opts)
+		require.Nil(t, errs)
+		assert.Nil(t, validationErrors)
+	})
+
+	t.Run("errors_and_warnings", func(t *testing.T) {
+		opts := &Options{
+			Data:     map[string]any{"field": "a"},
+			Language: lang.New().GetDefault(),
+			Rules: RuleSet{
+				{Path: "field", Rules: List{Required(), String(), Warn(Min(3)), In([]string{"b"})}},
+			},
+		}
+
+		validationErrors, warnings, errs := ValidateWithWarnings(opts)
+		require.Nil(t, errs)
+		assert.Equal(t, &Errors{Fields: FieldsErrors{"field": {Errors: []string{"The field must have one of the following values: b."}}}}, validationErrors)
+		assert.Equal(t, &Errors{Fields: FieldsErrors{"field": {Errors: []string{"The field must be at least 3 characters."}}}}, warnings)
+	})
+
+	t.Run("added_errors", func(t *testing.T) {
+		v := &testValidator{
+			validateFunc: func(_ component, ctx *Context) bool {
+				ctx.AddArrayElementValidationErrors(1)
+				ctx.AddValidationError(walk.MustParse("other"), "added error")
+				return true
+			},
+		}
+		opts := &Options{
+			Data:     map[string]any{"array": []any{"a", "b"}},
+			Language: lang.New().GetDefault(),
+			Rules: RuleSet{
+				{Path: "array", Rules: List{Required(), Array(), Warn(v)}},
+			},
+		}
+
+		validationErrors, warnings, errs := ValidateWithWarnings(opts)
+		require.Nil(t, errs)
+		assert.Nil(t, validationErrors)
+		want := &Errors{
+			Fields: FieldsErrors{
+				"array": {Elements: ArrayErrors{1: {Errors: []string{"validation.rules.test_validator.element"}}}},
+				"other": {Errors: []string{"added error"}},
+			},
+		}
+		assert.Equal(t, want, warnings)
+	})
+
+	t.Run("missing_field", func(t *testing.T) {
+		opts := &Options{
+			Data:     map[string]any{},
+			Language: lang.New().GetDefault(),
+			Rules: RuleSet{
+				{Path: "field", Rules: List{String(), Warn(Min(3))}},
+			},
+		}
+
+		validationErrors, warnings, errs := ValidateWithWarnings(opts)
+		require.Nil(t, errs)
+		assert.Nil(t, validationErrors)
+		assert.Nil(t, warnings)
+	})
+
+	t.Run("panic_required_and_type", func(t *testing.T) {
+		assert.Panics(t, func() { Warn(Required()) })
+		assert.Panics(t, func() { Warn(RequiredIf(func(_ *Context) bool { return true })) })
+		assert.Panics(t, func() { Warn(String()) })
+		assert.Panics(t, func() { Warn(Array()) })
+		assert.NotPanics(t, func() { Warn(Min(3)) })
+	})
+
+	t.Run("no_warnings", func(t *testing.T) {
+		opts := &Options{
+			Data:     map[string]any{"field": "abc"},
+			Language: lang.New().GetDefault(),
+			Rules: RuleSet{
+				{Path: "field", Rules: List{Required(), String(), Warn(Min(3))}},
+			},
+		}
+
+		validationErrors, warnings, errs := ValidateWithWarnings(opts)
+		require.Nil(t, errs)
+		assert.Nil(t, validationErrors)
+		assert.Nil(t, warnings)
+	})
+}
+
+func TestValidateSkipTypeDependentOnTypeError(t *testing.T) {
+	cases := []struct {
+		want *Errors
+		desc string
+		skip bool
+	}{
+		{
+			desc: "skip",
+			skip: true,
+			want: &Errors{Fields: FieldsErrors{
+				"number": {Errors: []string{"The number must be numeric.", "The number must be a valid email address."}},
+			}},
+		},
+		{
+			desc: "no_skip",
+			skip: false,
+			want: &Errors{Fields: FieldsErrors{
+				"number": {Errors: []string{"The number must be numeric.", "The number must be at least 10.", "The number must be a valid email address."}},
+			}},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			opts := &Options{
+				Data:                         map[string]any{"number": "abc"},
+				Language:                     lang.New().GetDefault(),
+				SkipTypeDependentOnTypeError: c.skip,
+				Rules: RuleSet{
+					{Path: "number", Rules: List{Required(), Float64(), Min(10), Email()}},
+				},
+			}
+			validationErrors, errs := Validate(opts)
+			require.Nil(t, errs)
+			assert.Equal(t, c.want, validationErrors)
+		})
+	}
+}
+
+func TestValidateRecoverPanics(t *testing.T) {
+	panicking := &testValidator{
+		validateFunc: func(_ component, _ *Context) bool {
+			panic("unexpected type")
+		},
+	}
+	newOptions := func(recoverPanics bool) *Options {
+		return &Options{
+			Data:          map[string]any{"field": "value", "other": 1},
+			Language:      lang.New().GetDefault(),
+			RecoverPanics: recoverPanics,
+			Rules: RuleSet{
+				{Path: "field", Rules: List{Required(), panicking}},
+				{Path: "other", Rules: List{Required(), String()}},
+			},
+		}
+	}
+
+	t.Run("recover", func(t *testing.T) {
+		var validationErrors *Errors
+		var errs []error
+		assert.NotPanics(t, func() {
+			validationErrors, errs = Validate(newOptions(true))
+		})
+		assert.Nil(t, validationErrors)
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Error(), `validation: validator "test_validator" panicked: unexpected type`)
+	})
+
+	t.Run("no_recover", func(t *testing.T) {
+		assert.Panics(t, func() {
+			_, _ = Validate(newOptions(false))
+		})
+	})
+}
+
+func TestValidateMaxErrors(t *testing.T) {
+	countMessages := func(errs *Errors) int {
+		var count func(e *Errors) int
+		count = func(e *Errors) int {
+			if e == nil {
+				return 0
+			}
+			n := len(e.Errors)
+			for _, f := range e.Fields {
+				n += count(f)
+			}
+			for _, el := range e.Elements {
+				n += count(el)
+			}
+			return n
+		}
+		return count(errs)
+	}
+
+	newOptions := func(maxErrors int, calls *int) *Options {
+		values := make([]any, 0, 1000)
+		for range 1000 {
+			values = append(values, 1)
+		}
+		counting := &testValidator{
+			validateFunc: func(_ component, _ *Context) bool {
+				*calls++
+				return true
+			},
+		}
+		return &Options{
+			Data:      map[string]any{"values": values, "other": "a"},
+			Language:  lang.New().GetDefault(),
+			MaxErrors: maxErrors,
+			Rules: RuleSet{
+				{Path: "values", Rules: List{Required(), Array()}},
+				{Path: "values[]", Rules: List{Int(), Min(10), counting}},
+				{Path: "other", Rules: List{Required(), Int()}},
+			},
+		}
+	}
+
+	t.Run("capped", func(t *testing.T) {
+		calls := 0
+		validationErrors, errs := Validate(newOptions(5, &calls))
+		require.Empty(t, errs)
+		require.NotNil(t, validationErrors)
+		assert.Equal(t, 5, countMessages(validationErrors))
+		assert.Len(t, validationErrors.Fields["values"].Elements, 5)
+		assert.NotContains(t, validationErrors.Fields, "other")
+		assert.Equal(t, 4, calls) // Stopped early, the last element failing isn't followed by the counting validator
+	})
+
+	t.Run("unlimited", func(t *testing.T) {
+		calls := 0
+		validationErrors, errs := Validate(newOptions(0, &calls))
+		require.Empty(t, errs)
+		require.NotNil(t, validationErrors)
+		assert.Equal(t, 1001, countMessages(validationErrors))
+		assert.Len(t, validationErrors.Fields["values"].Elements, 1000)
+		assert.Equal(t, []string{"The other must be an integer."}, validationErrors.Fields["other"].Errors)
+		assert.Equal(t, 1000, calls)
+	})
+}
+
+func TestValidateContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	slow := &testValidator{
+		validateFunc: func(_ component, ctx *Context) bool {
+			select {
+			case <-ctx.Context.Done():
+				return false
+			case <-time.After(5 * time.Second):
+				return true
+			}
+		},
+	}
+	calls := 0
+	counting := &testValidator{
+		validateFunc: func(_ component, _ *Context) bool {
+			calls++
+			return true
+		},
+	}
+
+	opts := &Options{
+		Context:  ctx,
+		Data:     map[string]any{"field": "value", "other": "value"},
+		Language: lang.New().GetDefault(),
+		Rules: RuleSet{
+			{Path: "field", Rules: List{Required(), slow, counting}},
+			{Path: "other", Rules: List{Required(), counting}},
+		},
+	}
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	start := time.Now()
+	validationErrors, errs := Validate(opts)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Nil(t, validationErrors)
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], context.Canceled)
+	assert.Equal(t, 0, calls)
+
+	t.Run("cancelled_before", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		calls = 0
+		validationErrors, errs := Validate(&Options{
+			Context:  ctx,
+			Data:     map[string]any{"field": "value"},
+			Language: lang.New().GetDefault(),
+			Rules: RuleSet{
+				{Path: "field", Rules: List{Required(), counting}},
+			},
+		})
+		assert.Nil(t, validationErrors)
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], context.Canceled)
+		assert.Equal(t, 0, calls)
+	})
+}
+
+func TestValidateOnRuleComplete(t *testing.T) {
+	type call struct {
+		field  string
+		rule   string
+		passed bool
+	}
+	calls := []call{}
+	var total time.Duration
+	failing := &testValidator{
+		validateFunc: func(_ component, ctx *Context) bool {
+			ctx.AddError(fmt.Errorf("test error"))
+			return true
+		},
+	}
+
+	opts := &Options{
+		Data: map[string]any{
+			"name":  "John",
+			"age":   "12",
+			"tags":  []any{"a", 1},
+			"other": "a",
+		},
+		Language: lang.New().GetDefault(),
+		OnRuleComplete: func(field, rule string, passed bool, duration time.Duration) {
+			calls = append(calls, call{field: field, rule: rule, passed: passed})
+			total += duration
+		},
+		Rules: RuleSet{
+			{Path: "name", Rules: List{Required(), String(), Max(3)}},
+			{Path: "age", Rules: List{Required(), Int(), Min(18)}},
+			{Path: "tags", Rules: List{Required(), Array()}},
+			{Path: "tags[]", Rules: List{String()}},
+			{Path: "other", Rules: List{failing}},
+			{Path: "missing", Rules: List{String()}},
+		},
+	}
+	_, errs := Validate(opts)
+	require.Len(t, errs, 1)
+
+	assert.Equal(t, []call{
+		{field: "name", rule: "required", passed: true},
+		{field: "name", rule: "string", passed: true},
+		{field: "name", rule: "max", passed: false},
+		{field: "age", rule: "required", passed: true},
+		{field: "age", rule: "int", passed: true},
+		{field: "age", rule: "min", passed: false},
+		{field: "tags[0]", rule: "string", passed: true},
+		{field: "tags[1]", rule: "string", passed: false},
+		{field: "tags", rule: "required", passed: true},
+		{field: "tags", rule: "array", passed: true},
+		{field: "other", rule: "test_validator", passed: false},
+	}, calls)
+	assert.Positive(t, total)
+}
+
+func TestValidateNoMutation(t *testing.T) {
+	newOptions := func(noMutation bool) *Options {
+		return &Options{
+			Data: map[string]any{
+				"price":   "12.5",
+				"unknown": "a",
+				"items":   []any{map[string]any{"qty": "3"}},
+			},
+			Language:     lang.New().GetDefault(),
+			NoMutation:   noMutation,
+			StripUnknown: true,
+			Rules: RuleSet{
+				{Path: "price", Rules: List{Required(), Float64()}},
+				{Path: "items", Rules: List{Required(), Array()}},
+				{Path: "items[]", Rules: List{Required(), Object()}},
+				{Path: "items[].qty", Rules: List{Required(), Int(), Min(1)}},
+			},
+		}
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		opts := newOptions(true)
+		data := opts.Data
+		validationErrors, errs := Validate(opts)
+		require.Empty(t, errs)
+		assert.Nil(t, validationErrors)
+		assert.Equal(t, map[string]any{
+			"price":   "12.5",
+			"unknown": "a",
+			"items":   []any{map[string]any{"qty": "3"}},
+		}, opts.Data)
+		assert.Equal(t, data, opts.Data)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		opts := newOptions(false)
+		validationErrors, errs := Validate(opts)
+		require.Empty(t, errs)
+		assert.Nil(t, validationErrors)
+		assert.Equal(t, map[string]any{
+			"price": 12.5,
+			"items": []map[string]any{{"qty": 3}},
+		}, opts.Data)
+	})
+
+	t.Run("validators_see_converted_values", func(t *testing.T) {
+		opts := newOptions(true)
+		opts.Rules = RuleSet{
+			{Path: "price", Rules: List{Required(), Float64(), Min(20)}},
+		}
+		validationErrors, errs := Validate(opts)
+		require.Empty(t, errs)
+		require.NotNil(t, validationErrors)
+		assert.Equal(t, []string{"The price must be at least 20."}, validationErrors.Fields["price"].Errors)
+		assert.Equal(t, "12.5", opts.Data.(map[string]any)["price"])
+	})
+}
+
+func TestCloneData(t *testing.T) {
+	nested := map[string]any{"a": []any{1, map[string]any{"b": "c"}}}
+	data := map[string]any{"nested": nested, "strings": []string{"d"}}
+	clone := cloneData(data).(map[string]any)
+	assert.Equal(t, data, clone)
+
+	clone["nested"].(map[string]any)["a"].([]any)[1].(map[string]any)["b"] = "changed"
+	clone["nested"].(map[string]any)["a"].([]any)[0] = 2
+	assert.Equal(t, map[string]any{"a": []any{1, map[string]any{"b": "c"}}}, nested)
+
+	// Typed maps and slices resulting from conversions are copied too
+	clone["strings"].([]string)[0] = "changed"
+	assert.Equal(t, []string{"d"}, data["strings"])
+	objects := []map[string]any{{"f": []int{1}}, nil}
+	objectsClone := cloneData(objects).([]map[string]any)
+	assert.Equal(t, objects, objectsClone)
+	objectsClone[0]["f"].([]int)[0] = 2
+	assert.Equal(t, []int{1}, objects[0]["f"])
+	var nilSlice []string
+	assert.Equal(t, nilSlice, cloneData(nilSlice))
+
+	assert.Equal(t, "e", cloneData("e"))
+	assert.Nil(t, cloneData(nil))
+}
+
+func TestValidateRequest(t *testing.T) {
+	csrf := func(token *string, request **http.Request) *testValidator {
+		return &testValidator{
+			validateFunc: func(_ component, ctx *Context) bool {
+				*request = ctx.Request
+				if ctx.Request == nil {
+					return false
+				}
+				*token = ctx.Request.Header.Get("X-CSRF-Token")
+				return *token == ctx.Value
+			},
+		}
+	}
+
+	t.Run("with_request", func(t *testing.T) {
+		var token string
+		var request *http.Request
+		httpRequest := httptest.NewRequest(http.MethodPost, "/", nil)
+		httpRequest.Header.Set("X-CSRF-Token", "secret")
+		opts := &Options{
+			Data:     map[string]any{"token": "secret"},
+			Rules:    RuleSet{{Path: "token", Rules: List{Required(), csrf(&token, &request)}}},
+			Language: lang.New().GetDefault(),
+			Request:  httpRequest,
+		}
+		validationErrors, errs := Validate(opts)
+		require.Empty(t, errs)
+		assert.Nil(t, validationErrors)
+		assert.Equal(t, "secret", token)
+		assert.Equal(t, httpRequest, request)
+		assert.Equal(t, http.MethodPost, opts.Method) // Method taken from the request
+	})
+
+	t.Run("method_not_overridden", func(t *testing.T) {
+		opts := &Options{
+			Data:     map[string]any{},
+			Rules:    RuleSet{},
+			Language: lang.New().GetDefault(),
+			Request:  httptest.NewRequest(http.MethodPost, "/", nil),
+			Method:   http.MethodPatch,
+		}
+		_, errs := Validate(opts)
+		require.Empty(t, errs)
+		assert.Equal(t, http.MethodPatch, opts.Method)
+	})
+
+	t.Run("without_request", func(t *testing.T) {
+		var token string
+		var request *http.Request
+		validationErrors, errs := Validate(&Options{
+			Data:     map[string]any{"token": "secret"},
+			Rules:    RuleSet{{Path: "token", Rules: List{Required(), csrf(&token, &request)}}},
+			Language: lang.New().GetDefault(),
+		})
+		require.Empty(t, errs)
+		require.NotNil(t, validationErrors)
+		assert.Nil(t, request)
+		assert.Empty(t, token)
+	})
+}
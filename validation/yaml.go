@@ -0,0 +1,46 @@
+package validation
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// YAMLValidator validates the field under validation must be a valid YAML string.
+// Documents abusing aliases to expand to a huge structure ("billion laughs" attack)
+// are rejected by the decoder.
+//
+// If `Convert` is true, the field value is replaced with the decoded value
+// and the validator is considered a type validator.
+type YAMLValidator struct {
+	BaseValidator
+	Convert bool
+}
+
+// Validate checks the field under validation satisfies this validator's criteria.
+func (v *YAMLValidator) Validate(ctx *Context) bool {
+	str, ok := ctx.Value.(string)
+	if !ok {
+		return false
+	}
+	var data any
+	if err := yaml.Unmarshal([]byte(str), &data); err != nil {
+		return false
+	}
+	if v.Convert {
+		ctx.Value = data
+	}
+	return true
+}
+
+// Name returns the string name of the validator.
+func (v *YAMLValidator) Name() string { return "yaml" }
+
+// IsType returns true if `Convert` is true.
+func (v *YAMLValidator) IsType() bool { return v.Convert }
+
+// YAML the field under validation must be a valid YAML string. Documents abusing aliases
+// to expand to a huge structure ("billion laughs" attack) are rejected.
+//
+// Set the `Convert` field of the returned validator to true to replace the field value with the decoded value.
+func YAML() *YAMLValidator {
+	return &YAMLValidator{}
+}
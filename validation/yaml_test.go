@@ -0,0 +1,71 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func makeYAMLAliasBomb() string {
+	b := &strings.Builder{}
+	b.WriteString("a: &a [\"lol\",\"lol\",\"lol\",\"lol\",\"lol\",\"lol\",\"lol\",\"lol\",\"lol\"]\n")
+	prev := "a"
+	for _, name := range []string{"b", "c", "d", "e", "f", "g", "h", "i"} {
+		fmt.Fprintf(b, "%s: &%s [*%s,*%s,*%s,*%s,*%s,*%s,*%s,*%s,*%s]\n", name, name, prev, prev, prev, prev, prev, prev, prev, prev, prev)
+		prev = name
+	}
+	return b.String()
+}
+
+func TestYAMLValidator(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		v := YAML()
+		assert.NotNil(t, v)
+		assert.Equal(t, "yaml", v.Name())
+		assert.False(t, v.IsType())
+		assert.False(t, v.IsTypeDependent())
+		assert.Empty(t, v.MessagePlaceholders(&Context{}))
+
+		v.Convert = true
+		assert.True(t, v.IsType())
+	})
+
+	cases := []struct {
+		value any
+		desc  string
+		want  bool
+	}{
+		{desc: "object", value: "a: 1\nb:\n  - c\n  - d\n", want: true},
+		{desc: "scalar", value: "string", want: true},
+		{desc: "empty", value: "", want: true},
+		{desc: "flow", value: "{a: [1, 2]}", want: true},
+		{desc: "invalid indentation", value: "a:\n  b: 1\n c: 2\n", want: false},
+		{desc: "unclosed flow", value: "{a: [1, 2}", want: false},
+		{desc: "tab indentation", value: "a:\n\tb: 1", want: false},
+		{desc: "alias bomb", value: makeYAMLAliasBomb(), want: false},
+		{desc: "not a string", value: 1, want: false},
+		{desc: "bytes", value: []byte("a: 1"), want: false},
+		{desc: "nil", value: nil, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("Validate_%s_%t", c.desc, c.want), func(t *testing.T) {
+			v := YAML()
+			ctx := &Context{
+				Value: c.value,
+			}
+			assert.Equal(t, c.want, v.Validate(ctx))
+			assert.Equal(t, c.value, ctx.Value)
+		})
+	}
+
+	t.Run("Convert", func(t *testing.T) {
+		v := YAML()
+		v.Convert = true
+		ctx := &Context{Value: "a: 1\nb:\n  - c\n  - d\n"}
+		assert.True(t, v.Validate(ctx))
+		assert.Equal(t, map[string]any{"a": 1, "b": []any{"c", "d"}}, ctx.Value)
+	})
+}